@@ -4,44 +4,82 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
-	"io"
+	"log/slog"
+	"math/rand"
 	"net/http"
-	"os"
 	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/client"
+	tlog "go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
 
 	"github.com/slack-go/slack"
 )
 
+// GameWorkflowID is the workflow ID used for a game's GameWorkflow
+func GameWorkflowID(gameID string) string {
+	return "game-" + gameID
+}
+
 // Start a game workflow
 func StartGameWorkflowActivity(ctx context.Context, game Game) error {
 	logger := activity.GetLogger(ctx)
 	logger.Info("Starting a game workflow with game ID ", "gameID", game.ID)
 
-	// We don't need to worry about duplicate "games" being created because we're using the game ID - if we try to start a second workflow with the same
-	// game ID -> workflow ID, the default of the Go SDK is to just return the run ID of the already running workflow. Other SDKs will have different defaults!
-	var workflowID = "game-" + game.ID
-
-	TaskQueueName := os.Getenv("TASK_QUEUE")
-	if TaskQueueName == "" {
+	cfg := CurrentConfig()
+	if cfg.TaskQueue == "" {
 		return fmt.Errorf("TASK_QUEUE environment variable is not set")
 	}
 
-	options := client.StartWorkflowOptions{
-		ID:        workflowID,
-		TaskQueue: TaskQueueName,
-	}
-	c, err := client.Dial(GetClientOptions())
+	c, err := client.Dial(NewClientOptions(cfg))
 	if err != nil {
 		return fmt.Errorf("unable to create Temporal client: %w", err)
 	}
 	defer c.Close()
 
-	// Start the workflow with the Game object sent in
-	we, err := c.ExecuteWorkflow(context.Background(), options, GameWorkflow, game)
+	return startGameWorkflow(ctx, logger, c, cfg.TaskQueue, withNotificationSettings(game, cfg))
+}
+
+// withNotificationSettings fills in the game's notification types and channels from the config, unless it already has
+// them - decided here, outside the workflow, so GameWorkflow replays the same whatever the worker's config is later
+func withNotificationSettings(game Game, cfg Config) Game {
+	if len(game.NotificationTypes) == 0 {
+		game.NotificationTypes = cfg.NotificationTypes
+	}
+	if len(game.NotificationChannels) == 0 {
+		game.NotificationChannels = cfg.NotificationChannels
+	}
+	return game
+}
+
+// startGameWorkflow starts the game's GameWorkflow, or does nothing if it's already been started. It's safe to call
+// again for the same game - a retried activity, or a CollectGamesWorkflow picking back up after a worker restart.
+func startGameWorkflow(ctx context.Context, logger tlog.Logger, c client.Client, taskQueue string, game Game) error {
+	// The workflow ID is the game ID, so a game can only be tracked once. If it's still running, ExecuteWorkflow just
+	// returns that run (the Go SDK's default - other SDKs differ, so it's spelled out here). If it's already finished,
+	// it isn't started again unless it failed.
+	options := client.StartWorkflowOptions{
+		ID:                                       GameWorkflowID(game.ID),
+		TaskQueue:                                taskQueue,
+		WorkflowIDReusePolicy:                    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE_FAILED_ONLY,
+		WorkflowExecutionErrorWhenAlreadyStarted: false,
+	}
+
+	we, err := c.ExecuteWorkflow(ctx, options, GameWorkflow, game)
+	var alreadyStarted *serviceerror.WorkflowExecutionAlreadyStarted
+	if errors.As(err, &alreadyStarted) {
+		logger.Info("Game workflow has already run, not starting it again", "gameID", game.ID, "WorkflowID", options.ID)
+		return nil
+	}
 	if err != nil {
 		return fmt.Errorf("unable to execute workflow: %w", err)
 	}
@@ -54,135 +92,450 @@ func GetGamesActivity(ctx context.Context, trackingRequest TrackingRequest) ([]G
 	logger := activity.GetLogger(ctx)
 	logger.Info("Fetching games from ESPN API")
 
+	// Conferences are passed straight through to ESPN's ?groups= param, so any ESPN group ID works
+	// (not just the ones the UI lists), but they have to be numeric or we'd build a malformed URL
+	if err := validateGroupIDs(trackingRequest.Conferences); err != nil {
+		return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidTrackingRequest", nil)
+	}
+
 	// Use the trackingRequest (sport and league) to build the URL
-	var apiRoot string = fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/%s/%s", trackingRequest.Sport, trackingRequest.League)
+	var apiRoot string = DefaultESPNClient.APIRoot(trackingRequest.Sport, trackingRequest.League)
 	scoreboardUrl := apiRoot + "/scoreboard" //If you don't specify a conference, it will give you the top 25 games across all conferences
 
 	var games []Game
 
-	// if trackingRequest.Conferences is not empty, hit API for each conference and combine results
+	// if trackingRequest.Conferences is not empty, hit API for each conference and combine results. A conference
+	// division (SEC East) is a group like any other, but its games are also its conference's, and a game between two
+	// requested conferences is in both - each game is only kept the first time it turns up.
 	if len(trackingRequest.Conferences) > 0 {
+		seen := make(map[string]bool)
 		for _, conf := range trackingRequest.Conferences {
 			url := fmt.Sprintf("%s/scoreboard?groups=%s", apiRoot, conf)
-			resp, err := http.Get(url)
-			if err != nil {
-				return nil, fmt.Errorf("failed to fetch games: %w", err)
-			}
-			defer resp.Body.Close()
-
-			body, err := io.ReadAll(resp.Body)
-			if err != nil {
-				return nil, fmt.Errorf("failed to read response body: %w", err)
-			}
-
 			var espnResp ESPNResponse
-			if err := json.Unmarshal(body, &espnResp); err != nil {
-				return nil, fmt.Errorf("failed to unmarshal ESPN response: %w", err)
+			if err := DefaultESPNClient.GetJSON(ctx, url, &espnResp); err != nil {
+				logESPNDecodeError(logger, err)
+				return nil, err
 			}
 
 			// Process every game in this conference
 			for _, event := range espnResp.Events {
 				logger.Info("Processing event", "name", event.Name)
-				if len(event.Competitions) > 0 && len(event.Competitions[0].Competitors) >= 2 {
-					comp := event.Competitions[0]
+				if seen[event.ID] {
+					continue
+				}
+				seen[event.ID] = true
+				comp, ok := headToHeadCompetition(event)
+				if !ok {
+					logger.Warn("Skipping event without exactly two team competitors", "eventID", event.ID, "name", event.Name)
+					continue
+				}
 
-					homeTeam := comp.Competitors[0]
-					awayTeam := comp.Competitors[1]
-					logger.Info("Home Team name", "name", homeTeam.Team.Name)
-					logger.Info("Away Team name", "name", awayTeam.Team.Name)
+				homeTeam := comp.Competitors[0]
+				awayTeam := comp.Competitors[1]
+				logger.Info("Home Team name", "name", homeTeam.Team.Name)
+				logger.Info("Away Team name", "name", awayTeam.Team.Name)
 
-					game := BuildGame(comp, homeTeam, awayTeam, apiRoot, trackingRequest)
-					games = append(games, game)
-				}
+				game := BuildGame(event.ID, comp, homeTeam, awayTeam, apiRoot, trackingRequest)
+				warnSchemaIssues(logger, event.ID, comp)
+				game.Group = conf // the general scoreboard only has featured games, so score updates need the same group
+				games = append(games, game)
 			}
 		}
 	}
 	
-	// if trackingRequest.Teams is not empty, hit the general scoreboard and filter results for those teams
-	if len(trackingRequest.Teams) > 0 {
-		resp, err := http.Get(scoreboardUrl)
-		if err != nil {
-			return nil, fmt.Errorf("failed to fetch games: %w", err)
+	// if trackingRequest.Teams or GameIDs is not empty, hit the general scoreboard and filter results for those teams/games
+	if len(trackingRequest.Teams) > 0 || len(trackingRequest.GameIDs) > 0 {
+		var espnResp ESPNResponse
+		if err := DefaultESPNClient.GetJSON(ctx, scoreboardUrl, &espnResp); err != nil {
+			logESPNDecodeError(logger, err)
+			return nil, err
 		}
-		defer resp.Body.Close()
 
-		body, err := io.ReadAll(resp.Body)
+		// Teams can be given by name or abbreviation ("Michigan", "MICH") as well as ESPN ID, so map them to IDs first
+		teamIDs, err := resolveTeamIDs(trackingRequest.Teams, scoreboardTeams(espnResp))
 		if err != nil {
-			return nil, fmt.Errorf("failed to read response body: %w", err)
-		}
-
-		var espnResp ESPNResponse
-		if err := json.Unmarshal(body, &espnResp); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal ESPN response: %w", err)
+			return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidTrackingRequest", nil)
 		}
 
 		for _, event := range espnResp.Events {
 			logger.Info("Processing event", "name", event.Name)
-			if len(event.Competitions) > 0 && len(event.Competitions[0].Competitors) >= 2 {
-				comp := event.Competitions[0]
-
-				homeTeam := comp.Competitors[0]
-				awayTeam := comp.Competitors[1]
-				logger.Info("Home Team name", "name", homeTeam.Team.Name)
-				logger.Info("Away Team name", "name", awayTeam.Team.Name)
+			comp, ok := headToHeadCompetition(event)
+			if !ok {
+				logger.Warn("Skipping event without exactly two team competitors", "eventID", event.ID, "name", event.Name)
+				continue
+			}
 
-				// Filter games by teams in the request
-				if slices.Contains(trackingRequest.Teams, homeTeam.Team.ID) ||
-					slices.Contains(trackingRequest.Teams, awayTeam.Team.ID) {
-					game := BuildGame(comp, homeTeam, awayTeam, apiRoot, trackingRequest)
-					games = append(games, game)
-				}
+			homeTeam := comp.Competitors[0]
+			awayTeam := comp.Competitors[1]
+			logger.Info("Home Team name", "name", homeTeam.Team.Name)
+			logger.Info("Away Team name", "name", awayTeam.Team.Name)
+
+			// Filter games by teams and games in the request
+			if slices.Contains(teamIDs, homeTeam.Team.ID) ||
+				slices.Contains(teamIDs, awayTeam.Team.ID) ||
+				slices.Contains(trackingRequest.GameIDs, event.ID) {
+				game := BuildGame(event.ID, comp, homeTeam, awayTeam, apiRoot, trackingRequest)
+				warnSchemaIssues(logger, event.ID, comp)
+				games = append(games, game)
 			}
 		}
 	}
 
+	// Narrow down to top 25 matchups if requested
+	if trackingRequest.RankedOnly {
+		games = filterRankedGames(games, trackingRequest.BothRanked)
+		logger.Info("Filtered to ranked matchups", "count", len(games), "bothRanked", trackingRequest.BothRanked)
+	}
+
+	// Drop games that aren't on TV if requested
+	if trackingRequest.TVOnly {
+		games = filterTVGames(games)
+		logger.Info("Filtered to televised games", "count", len(games))
+	}
+
 	logger.Info("Fetched games", "count", len(games))
 	return games, nil
 }
 
+// filterTVGames keeps games ESPN lists a TV network for
+func filterTVGames(games []Game) []Game {
+	var televised []Game
+	for _, game := range games {
+		if game.TVNetwork != "" {
+			televised = append(televised, game)
+		}
+	}
+	return televised
+}
+
+// tvNetwork returns the competition's TV network(s), e.g. "ESPN" or "FOX/FS1", from the broadcasts list if ESPN
+// didn't fill in the summary field
+func tvNetwork(comp Competition) string {
+	if comp.Broadcast != "" {
+		return comp.Broadcast
+	}
+	var names []string
+	for _, broadcast := range comp.Broadcasts {
+		for _, name := range broadcast.Names {
+			if name != "" && !slices.Contains(names, name) {
+				names = append(names, name)
+			}
+		}
+	}
+	return strings.Join(names, "/")
+}
+
+// scoreboardTeams returns every team playing on a scoreboard
+func scoreboardTeams(espnResp ESPNResponse) []Team {
+	var teams []Team
+	for _, event := range espnResp.Events {
+		for _, comp := range event.Competitions {
+			for _, competitor := range comp.Competitors {
+				teams = append(teams, competitor.Team)
+			}
+		}
+	}
+	return teams
+}
+
+// resolveTeamIDs turns each requested team into an ESPN team ID. Numeric entries are already IDs; anything else is
+// matched (case-insensitively) against the abbreviation, display name, location, or name of the teams on the scoreboard.
+// A name matching more than one team is an error listing the candidates. A name matching no team is skipped, since that
+// team just isn't playing on this scoreboard.
+func resolveTeamIDs(requested []string, teams []Team) ([]string, error) {
+	var teamIDs []string
+	for _, entry := range requested {
+		entry = strings.TrimSpace(entry)
+		if _, err := strconv.ParseUint(entry, 10, 64); err == nil {
+			teamIDs = append(teamIDs, entry)
+			continue
+		}
+
+		matches := make(map[string]Team)
+		for _, team := range teams {
+			if strings.EqualFold(entry, team.Abbreviation) || strings.EqualFold(entry, team.DisplayName) ||
+				strings.EqualFold(entry, team.Location) || strings.EqualFold(entry, team.Name) {
+				matches[team.ID] = team
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			continue
+		case 1:
+			for id := range matches {
+				teamIDs = append(teamIDs, id)
+			}
+		default:
+			var candidates []string
+			for _, team := range matches {
+				candidates = append(candidates, fmt.Sprintf("%s (%s, ID %s)", team.DisplayName, team.Abbreviation, team.ID))
+			}
+			sort.Strings(candidates)
+			return nil, fmt.Errorf("team %q is ambiguous, it matches: %s", entry, strings.Join(candidates, ", "))
+		}
+	}
+	return teamIDs, nil
+}
+
+// validateGroupIDs makes sure every conference is a numeric ESPN group ID, e.g. "5" for the Big Ten in college football
+func validateGroupIDs(conferences []string) error {
+	for _, conf := range conferences {
+		if _, err := strconv.ParseUint(conf, 10, 64); err != nil {
+			return fmt.Errorf("invalid conference group ID %q: ESPN group IDs must be numeric", conf)
+		}
+	}
+	return nil
+}
+
+// filterRankedGames keeps games where at least one team (or both, if bothRanked is set) is ranked
+func filterRankedGames(games []Game, bothRanked bool) []Game {
+	var ranked []Game
+	for _, game := range games {
+		homeRanked := game.HomeTeam.Rank > 0
+		awayRanked := game.AwayTeam.Rank > 0
+		if (bothRanked && homeRanked && awayRanked) || (!bothRanked && (homeRanked || awayRanked)) {
+			ranked = append(ranked, game)
+		}
+	}
+	return ranked
+}
+
+// headToHeadCompetition returns the event's competition if it's a regular two-team matchup. Individual sports and
+// multi-competitor events (relays, races) don't fit the home/away model, so callers skip those.
+func headToHeadCompetition(event Event) (Competition, bool) {
+	if len(event.Competitions) == 0 {
+		return Competition{}, false
+	}
+	comp := event.Competitions[0]
+	if len(comp.Competitors) != 2 {
+		return comp, false
+	}
+	for _, competitor := range comp.Competitors {
+		if competitor.Team.ID == "" {
+			return comp, false
+		}
+	}
+	return comp, true
+}
+
+// assignHomeAway works out which competitor is home by scanning for the one marked "home" (or failing that, the other
+// side of the one marked "away"). When neither tells them apart - both "home", "neutral", or left off - it keeps the
+// order given and reports the game as neutral-site.
+func assignHomeAway(first Competitor, second Competitor) (home Competitor, away Competitor, neutral bool) {
+	switch {
+	case first.HomeAway == "home" && second.HomeAway != "home":
+		return first, second, false
+	case second.HomeAway == "home" && first.HomeAway != "home":
+		return second, first, false
+	case first.HomeAway == "away" && second.HomeAway != "away":
+		return second, first, false
+	case second.HomeAway == "away" && first.HomeAway != "away":
+		return first, second, false
+	}
+	return first, second, true
+}
+
+// ESPN reports unranked teams with a curated rank of 99, so only 1-25 counts as ranked
+func rankFromCompetitor(competitor Competitor) int {
+	if competitor.CuratedRank.Current >= 1 && competitor.CuratedRank.Current <= 25 {
+		return competitor.CuratedRank.Current
+	}
+	return 0
+}
+
+// recordFromCompetitor returns the competitor's overall record, falling back to the first one ESPN lists
+func recordFromCompetitor(competitor Competitor) string {
+	for _, record := range competitor.Records {
+		if record.Type == "total" || record.Name == "overall" {
+			return record.Summary
+		}
+	}
+	if len(competitor.Records) > 0 {
+		return competitor.Records[0].Summary
+	}
+	return ""
+}
+
+// lineScoresFromCompetitor returns the competitor's points by period, 1st period first, or nil if ESPN didn't send any
+func lineScoresFromCompetitor(competitor Competitor) []int {
+	var points []int
+	for i, lineScore := range competitor.LineScores {
+		period := lineScore.Period
+		if period <= 0 {
+			period = i + 1
+		}
+		for len(points) < period {
+			points = append(points, 0)
+		}
+		points[period-1] = int(lineScore.Value)
+	}
+	return points
+}
+
+// lineScores returns the competitors' points by period keyed by team ID, leaving out any without linescores
+func lineScores(competitors ...Competitor) map[string][]int {
+	byTeam := make(map[string][]int)
+	for _, competitor := range competitors {
+		if points := lineScoresFromCompetitor(competitor); points != nil {
+			byTeam[competitor.Team.ID] = points
+		}
+	}
+	return byTeam
+}
+
+// gameStatus returns ESPN's state for the game ("pre", "in", or "post"). A completed game is always "post", and the
+// status type name (e.g. "STATUS_FINAL") stands in if the state is missing.
+func gameStatus(status Status) string {
+	if status.Type.Completed {
+		return GameStatePost
+	}
+	if status.Type.State != "" {
+		return status.Type.State
+	}
+	return gameState(status.Type.Name)
+}
+
+// statusDetail returns ESPN's description of where the game is, preferring the long form
+func statusDetail(status Status) string {
+	if status.Type.Detail != "" {
+		return status.Type.Detail
+	}
+	return status.Type.ShortDetail
+}
+
 // Helper function to create a Game from a Competition and its Competitors
-func BuildGame(comp Competition, homeTeam Competitor, awayTeam Competitor, apiRoot string, request TrackingRequest) Game {
+func BuildGame(eventID string, comp Competition, homeTeam Competitor, awayTeam Competitor, apiRoot string, request TrackingRequest) Game {
 	game := Game{
 		ID:           comp.ID,
+		EventID:      eventID,
+		GameURL:      espnGameURL(request.Sport, request.League, eventID),
 		Sport: 	 	  request.Sport,
 		League: 	  request.League,
 		StartTime:    comp.Date.Time,
-		Status:       comp.Status.Type.State,
+		Status:       gameStatus(comp.Status),
 		APIRoot:      apiRoot,
 		CurrentScore: make(map[string]string),
-		TVNetwork:    comp.Broadcast,
+		TVNetwork:    tvNetwork(comp),
 		DisplayClock: comp.Status.DisplayClock,
+		StatusDetail: statusDetail(comp.Status),
 		NumberOfPeriods: comp.Format.Regulation.NumberOfPeriods,
 		UnderdogWinning: false,
+		MinNotifyInterval: time.Duration(request.MinNotifyInterval),
+		ActivityTimeouts: request.ActivityTimeouts,
+		ActivityRetry: request.ActivityRetry,
+		RecordResult: CurrentConfig().ResultsWebhookURL != "", // decided here so GameWorkflow doesn't have to read the config
+		FocusTeams:   request.FocusTeams,
+		BatchNotifications: request.BatchNotifications,
+		WinProbabilityThreshold: request.WinProbabilityThreshold,
+		UnderdogCooldownPeriods: request.UnderdogCooldownPeriods,
+		ComebackMargin: request.ComebackMargin,
+		StartImmediately: request.StartImmediately,
+		MinScoreDelta: request.MinScoreDelta,
+		FinalConfirmPolls: request.FinalConfirmPolls,
+		FavoriteTrailingFromPeriod: request.FavoriteTrailingFromPeriod,
+		ReminderLead: time.Duration(request.ReminderLead),
+		Timezone: request.Timezone,
 	}
 
 	game.CurrentPeriod = fmt.Sprintf("%d", int(comp.Status.Period))
-	
-	// Determine home and away teams
-	if homeTeam.HomeAway == "home" {
-		game.HomeTeam = homeTeam.Team
-		game.AwayTeam = awayTeam.Team
-		game.CurrentScore[homeTeam.Team.ID] = homeTeam.Score
-		game.CurrentScore[awayTeam.Team.ID] = awayTeam.Score
-	} else {
-		game.HomeTeam = awayTeam.Team
-		game.AwayTeam = homeTeam.Team
-		game.CurrentScore[awayTeam.Team.ID] = awayTeam.Score
-		game.CurrentScore[homeTeam.Team.ID] = homeTeam.Score
+	if game.NumberOfPeriods == 0 {
+		// ESPN left the format off - overtime detection needs to know when regulation ends
+		game.NumberOfPeriods = regulationPeriods(request.Sport, request.League)
 	}
+	
+	// Determine home and away teams from each competitor's homeAway, not the order ESPN lists them in
+	home, away, neutral := assignHomeAway(homeTeam, awayTeam)
+	game.NeutralSite = comp.NeutralSite || neutral
+	game.HomeTeam = home.Team
+	game.AwayTeam = away.Team
+	game.CurrentScore[home.Team.ID] = home.Score
+	game.CurrentScore[away.Team.ID] = away.Score
+	game.LineScores = lineScores(home, away)
+	game.HomeTeam.Rank = rankFromCompetitor(home)
+	game.AwayTeam.Rank = rankFromCompetitor(away)
+	game.HomeTeam.Record = recordFromCompetitor(home)
+	game.AwayTeam.Record = recordFromCompetitor(away)
 
 	// Set favorite and underdog based on odds
 	if len(comp.Odds) > 0 {
 		game.Odds = comp.Odds[0].Details
-		game.HomeTeam.Favorite = comp.Odds[0].HomeTeamOdds.Favorite
-		game.HomeTeam.Underdog = comp.Odds[0].HomeTeamOdds.Underdog
-		game.AwayTeam.Favorite = comp.Odds[0].AwayTeamOdds.Favorite
-		game.AwayTeam.Underdog = comp.Odds[0].AwayTeamOdds.Underdog
+		if comp.Odds[0].HomeTeamOdds != nil {
+			game.HomeTeam.Favorite = comp.Odds[0].HomeTeamOdds.Favorite
+			game.HomeTeam.Underdog = comp.Odds[0].HomeTeamOdds.Underdog
+		}
+		if comp.Odds[0].AwayTeamOdds != nil {
+			game.AwayTeam.Favorite = comp.Odds[0].AwayTeamOdds.Favorite
+			game.AwayTeam.Underdog = comp.Odds[0].AwayTeamOdds.Underdog
+		}
 	}
 
 	return game
 }
 
+// espnGameURL links to ESPN's page for the game, e.g. https://www.espn.com/college-football/game/_/gameId/401520281.
+// ESPN puts the league in the path, except soccer where it's /soccer/match/.
+func espnGameURL(sport string, league string, eventID string) string {
+	if eventID == "" {
+		return ""
+	}
+	if sport == "soccer" {
+		return fmt.Sprintf("https://www.espn.com/soccer/match/_/gameId/%s", eventID)
+	}
+	return fmt.Sprintf("https://www.espn.com/%s/game/_/gameId/%s", league, eventID)
+}
+
+// logESPNDecodeError logs the body of an ESPN response that didn't parse when ESPN_DEBUG is on. When ESPN changes
+// shape the payload is the only way to see how, but it's big, so only when asked.
+func logESPNDecodeError(logger tlog.Logger, err error) {
+	var decodeErr *ESPNDecodeError
+	if DefaultESPNClient.Debug && errors.As(err, &decodeErr) {
+		logger.Warn("ESPN response didn't parse", "url", decodeErr.URL, "error", decodeErr.Err, "bytes", decodeErr.Size, "body", decodeErr.Body)
+	}
+}
+
+// warnSchemaIssues logs anything about the event's competition that suggests ESPN changed its response shape
+func warnSchemaIssues(logger tlog.Logger, eventID string, comp Competition) {
+	for _, issue := range competitionSchemaIssues(comp) {
+		logger.Warn("Possible ESPN schema change", "eventID", eventID, "issue", issue)
+	}
+}
+
+// competitionSchemaIssues looks for signs that ESPN changed the shape of its response. Our unmarshal quietly leaves
+// zero values behind when a field moves, so favorite/underdog detection would stop working without any error.
+func competitionSchemaIssues(comp Competition) []string {
+	var issues []string
+
+	if len(comp.Competitors) > 0 {
+		hasHome, hasAway := false, false
+		for _, competitor := range comp.Competitors {
+			switch competitor.HomeAway {
+			case "home":
+				hasHome = true
+			case "away":
+				hasAway = true
+			}
+		}
+		if !hasHome || !hasAway {
+			issues = append(issues, "competitors are missing a home/away designation")
+		}
+	}
+
+	if len(comp.Odds) > 0 {
+		odds := comp.Odds[0]
+		if odds.HomeTeamOdds == nil || odds.AwayTeamOdds == nil {
+			issues = append(issues, "odds are missing homeTeamOdds/awayTeamOdds")
+		} else if !odds.HomeTeamOdds.Favorite && !odds.AwayTeamOdds.Favorite {
+			issues = append(issues, "odds are present but neither team is marked favorite")
+		}
+	}
+
+	return issues
+}
+
+// GameNotFoundErrorType is returned by GetGameScoreActivity when the game is no longer on ESPN's scoreboard
+const GameNotFoundErrorType = "GameNotFound"
+
 // GetGameScoreActivity fetches current score for a specific game
 func GetGameScoreActivity(ctx context.Context, game Game) (Game, error) {
 	logger := activity.GetLogger(ctx)
@@ -190,22 +543,16 @@ func GetGameScoreActivity(ctx context.Context, game Game) (Game, error) {
 	
 	var gameUpdate Game
 	url := game.APIRoot + "/scoreboard"
+	if game.Group != "" {
+		// Look where the game was found - conference games outside the featured set aren't on the general scoreboard
+		url += "?groups=" + game.Group
+	}
 //	url := fmt.Sprintf("%s/summary?event=%s", game.APIRoot, game.ID) //Example: https://site.api.espn.com/apis/site/v2/sports/football/college-football/summary?event=:gameId
 	
-	resp, err := http.Get(url)
-	if err != nil {
-		return gameUpdate, fmt.Errorf("failed to fetch game score: %w", err)
-	}
-	defer resp.Body.Close()
-
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return gameUpdate, fmt.Errorf("failed to read response body: %w", err)
-	}
-
 	var espnResp ESPNResponse
-	if err := json.Unmarshal(body, &espnResp); err != nil {
-		return gameUpdate, fmt.Errorf("failed to unmarshal ESPN response: %w", err)
+	if err := DefaultESPNClient.GetJSON(ctx, url, &espnResp); err != nil {
+		logESPNDecodeError(logger, err)
+		return gameUpdate, err
 	}
 
 	// Find the specific game
@@ -223,26 +570,118 @@ func GetGameScoreActivity(ctx context.Context, game Game) (Game, error) {
 			if comp.Status.DisplayClock != "" {
 				gameUpdate.DisplayClock = comp.Status.DisplayClock
 			}
+			gameUpdate.StatusDetail = statusDetail(comp.Status)
+			gameUpdate.Status = gameStatus(comp.Status)
 			gameUpdate.CurrentScore = scores
+			gameUpdate.LineScores = lineScores(comp.Competitors...)
 			logger.Info("Fetched game score", "gameID", game.ID, "period", gameUpdate.CurrentPeriod, "displayClock", gameUpdate.DisplayClock, "scores", gameUpdate.CurrentScore)
 			return gameUpdate, nil
 		}
 	}
 
-	return gameUpdate, fmt.Errorf("game not found: %s", game.ID)
+	// Games drop off the scoreboard once they're over, so this won't fix itself on a retry
+	return gameUpdate, temporal.NewNonRetryableApplicationError(fmt.Sprintf("game not found: %s", game.ID), GameNotFoundErrorType, nil)
+}
+
+// NoWinProbabilityErrorType is returned by GetWinProbabilityActivity when ESPN has no win probability for the game (yet)
+const NoWinProbabilityErrorType = "NoWinProbability"
+
+// GetWinProbabilityActivity fetches ESPN's latest in-game win probability for a game
+func GetWinProbabilityActivity(ctx context.Context, game Game) (WinProbability, error) {
+	logger := activity.GetLogger(ctx)
+
+	eventID := game.EventID
+	if eventID == "" {
+		eventID = game.ID
+	}
+	url := fmt.Sprintf("%s/summary?event=%s", game.APIRoot, eventID) //Example: https://site.api.espn.com/apis/site/v2/sports/football/college-football/summary?event=:gameId
+
+	var summary SummaryResponse
+	if err := DefaultESPNClient.GetJSON(ctx, url, &summary); err != nil {
+		return WinProbability{}, err
+	}
+
+	// Not every sport or game has one, and there's nothing to retry until the next poll
+	if len(summary.WinProbability) == 0 {
+		return WinProbability{}, temporal.NewNonRetryableApplicationError(fmt.Sprintf("no win probability for game: %s", game.ID), NoWinProbabilityErrorType, nil)
+	}
+	latest := summary.WinProbability[len(summary.WinProbability)-1]
+	logger.Info("Fetched win probability", "gameID", game.ID, "homeWinPercentage", latest.HomeWinPercentage, "playID", latest.PlayID)
+	return latest, nil
+}
+
+// GetScoringPlaysActivity returns the game's scoring plays from ESPN's summary, oldest first. Games that haven't
+// had any (or sports ESPN doesn't list them for) just get an empty list.
+func GetScoringPlaysActivity(ctx context.Context, game Game) ([]ScoringPlay, error) {
+	logger := activity.GetLogger(ctx)
+
+	eventID := game.EventID
+	if eventID == "" {
+		eventID = game.ID
+	}
+	url := fmt.Sprintf("%s/summary?event=%s", game.APIRoot, eventID)
+
+	var summary SummaryResponse
+	if err := DefaultESPNClient.GetJSON(ctx, url, &summary); err != nil {
+		return nil, err
+	}
+
+	logger.Info("Fetched scoring plays", "gameID", game.ID, "count", len(summary.ScoringPlays))
+	return summary.ScoringPlays, nil
+}
+
+// notificationLogger returns the activity logger, or the default logger when a notification is sent directly (e.g. the web UI's test button in demo mode)
+func notificationLogger(ctx context.Context) tlog.Logger {
+	if activity.IsActivity(ctx) {
+		return activity.GetLogger(ctx)
+	}
+	return tlog.NewStructuredLogger(slog.Default())
+}
+
+// NewTestNotification is the canned notification used to check a channel is set up correctly
+func NewTestNotification() Notification {
+	return Notification{
+		Title:    "Test Notification",
+		Message:  "If you can read this, the Temporal Sports Tracker can reach this channel. Go team!",
+		Priority: PriorityNormal,
+	}
+}
+
+// UnknownChannelErrorType is returned by SendNotificationListActivity for a channel it doesn't know how to send to
+const UnknownChannelErrorType = "UnknownNotificationChannel"
+
+// supportedChannels are the channels SendNotificationListActivity can send to
+var supportedChannels = []string{"slack", "hass", "pagerduty", "ntfy", "logger"}
+
+// knownChannels splits channels into the ones SendNotificationListActivity supports and the rest, e.g. typos in
+// NOTIFICATION_CHANNELS
+func knownChannels(channels []string) (known []string, unknown []string) {
+	for _, channel := range channels {
+		if slices.Contains(supportedChannels, channel) {
+			known = append(known, channel)
+		} else {
+			unknown = append(unknown, channel)
+		}
+	}
+	return known, unknown
 }
 
 func SendNotificationListActivity(ctx context.Context, sendNotifications SendNotifications) error {
 	// For each notification message in the input list, send it to the specified channel in sendNotifications.Channel
 	// NOTE: This means that if one notification in the list fails, the whole activity fails and none of the notifications are sent.
 	// You could also do this with an activity per notification.
-	logger := activity.GetLogger(ctx)
+	logger := notificationLogger(ctx)
 	logger.Info("Sending notifications to channel", "channel", sendNotifications.Channel)
 	for _, notification := range sendNotifications.NotificationList {
 		// Call the appropriate activity based on the channel
 		switch sendNotifications.Channel {
 		case "slack":
 			err := SendSlackNotification(ctx, notification)
+			var appErr *temporal.ApplicationError
+			if errors.As(err, &appErr) {
+				// Returned as is - wrapping it would make a non-retryable error retryable again
+				return err
+			}
 			if err != nil {
 				return fmt.Errorf("failed to send Slack notification: %w", err)
 			}
@@ -251,81 +690,332 @@ func SendNotificationListActivity(ctx context.Context, sendNotifications SendNot
 			if err != nil {
 				return fmt.Errorf("failed to send Home Assistant notification: %w", err)
 			}
+		case "pagerduty":
+			err := SendPagerDutyNotification(ctx, notification)
+			if err != nil {
+				return fmt.Errorf("failed to send PagerDuty notification: %w", err)
+			}
+		case "ntfy":
+			err := SendNtfyNotification(ctx, notification)
+			if err != nil {
+				return fmt.Errorf("failed to send ntfy notification: %w", err)
+			}
 		case "logger":
-			logger := activity.GetLogger(ctx)
+			logger := notificationLogger(ctx)
 			logger.Info("Logger notification", "title", notification.Title, "message", notification.Message)
 		default:
-			return fmt.Errorf("unknown notification channel: %s", sendNotifications.Channel)
+			// Retrying won't make the channel exist
+			return temporal.NewNonRetryableApplicationError(fmt.Sprintf("unknown notification channel: %s", sendNotifications.Channel), UnknownChannelErrorType, nil)
 		}
 	}
 	return nil
 }
 
 func SendHomeAssistantNotification(ctx context.Context, notification Notification) error {
-	logger := activity.GetLogger(ctx)
+	logger := notificationLogger(ctx)
 	logger.Info("Sending Home Assistant notification", "title", notification.Title, "message", notification.Message)
 
-	hassWebhook := os.Getenv("HASS_WEBHOOK_URL")
+	hassWebhook := CurrentConfig().HassWebhookURL
 	if hassWebhook == "" {
 		return fmt.Errorf("HASS_WEBHOOK_URL environment variable is not set")
 	}
 	// Build the payload for Home Assistant
 	jsonScoreUpdate := map[string]string{
-		"title":   notification.Title,
-		"message": notification.Message,
-	}	
-	jsonData, err := json.Marshal(jsonScoreUpdate)
-	if err != nil {
-		return fmt.Errorf("failed to marshal JSON: %w", err)
+		"title":    notification.Title,
+		"message":  notification.Message,
+		"priority": notification.Priority, // available to the automation as trigger.json.priority, e.g. for the companion app's priority/interruption-level
+	}
+	if err := postNotificationJSON(ctx, hassWebhook, jsonScoreUpdate, http.StatusOK, http.StatusAccepted); err != nil {
+		return fmt.Errorf("Home Assistant webhook failed: %w", err)
+	}
+	return nil
+}
+
+// RecordGameResultActivity POSTs a finished game's result to RESULTS_WEBHOOK_URL so it can be archived
+func RecordGameResultActivity(ctx context.Context, result GameResult) error {
+	logger := activity.GetLogger(ctx)
+	logger.Info("Recording game result", "gameID", result.GameID, "homeScore", result.HomeScore, "awayScore", result.AwayScore)
+
+	resultsWebhook := CurrentConfig().ResultsWebhookURL
+	if resultsWebhook == "" {
+		return temporal.NewNonRetryableApplicationError("RESULTS_WEBHOOK_URL environment variable is not set", "MissingConfiguration", nil)
+	}
+	if err := postNotificationJSON(ctx, resultsWebhook, result, http.StatusOK, http.StatusCreated, http.StatusAccepted, http.StatusNoContent); err != nil {
+		return fmt.Errorf("results webhook failed: %w", err)
 	}
-	// Send the POST request to Home Assistant webhook with jsonData payload
-	req, err := http.NewRequest("POST", hassWebhook, io.NopCloser(io.Reader(bytes.NewReader(jsonData))))
+	return nil
+}
+
+// pagerDutyEventsURL is PagerDuty's Events API v2 endpoint. Tests point it at an httptest server.
+var pagerDutyEventsURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDutyEvent is the body of a PagerDuty Events API v2 trigger
+type PagerDutyEvent struct {
+	RoutingKey  string           `json:"routing_key"`
+	EventAction string           `json:"event_action"`
+	Payload     PagerDutyPayload `json:"payload"`
+}
+
+type PagerDutyPayload struct {
+	Summary       string            `json:"summary"`
+	Source        string            `json:"source"`
+	Severity      string            `json:"severity"` // critical, error, warning or info
+	CustomDetails map[string]string `json:"custom_details,omitempty"`
+}
+
+// SendPagerDutyNotification triggers a PagerDuty incident for the notification, for the "page me if we're losing in the 4th" crowd
+func SendPagerDutyNotification(ctx context.Context, notification Notification) error {
+	logger := notificationLogger(ctx)
+	logger.Info("Sending PagerDuty notification", "title", notification.Title, "priority", notification.Priority)
+
+	routingKey := CurrentConfig().PagerDutyRoutingKey
+	if routingKey == "" {
+		return fmt.Errorf("PAGERDUTY_ROUTING_KEY environment variable is not set")
+	}
+
+	// PagerDuty caps the summary at 1024 characters
+	summary := strings.TrimSpace(notification.Title + " " + strings.TrimSpace(notification.Message))
+	if len(summary) > 1024 {
+		summary = summary[:1024]
+	}
+
+	event := PagerDutyEvent{
+		RoutingKey:  routingKey,
+		EventAction: "trigger",
+		Payload: PagerDutyPayload{
+			Summary:  summary,
+			Source:   "temporal-sports-tracker",
+			Severity: pagerDutySeverity(notification.Priority),
+			CustomDetails: map[string]string{
+				"title":   notification.Title,
+				"message": notification.Message,
+			},
+		},
+	}
+	if err := postNotificationJSON(ctx, pagerDutyEventsURL, event, http.StatusAccepted); err != nil {
+		return fmt.Errorf("PagerDuty event failed: %w", err)
+	}
+	return nil
+}
+
+// pagerDutySeverity maps a notification priority to a PagerDuty severity
+func pagerDutySeverity(priority string) string {
+	switch priority {
+	case PriorityHigh:
+		return "critical"
+	case PriorityLow:
+		return "info"
+	default:
+		return "warning"
+	}
+}
+
+// SendNtfyNotification publishes the notification to NTFY_TOPIC on NTFY_SERVER (ntfy.sh by default). ntfy takes the
+// message as the body and everything else as headers.
+func SendNtfyNotification(ctx context.Context, notification Notification) error {
+	logger := notificationLogger(ctx)
+	logger.Info("Sending ntfy notification", "title", notification.Title, "priority", notification.Priority)
+
+	cfg := CurrentConfig()
+	if cfg.NtfyTopic == "" {
+		return fmt.Errorf("NTFY_TOPIC environment variable is not set")
+	}
+
+	headers := map[string]string{
+		"Content-Type": "text/plain; charset=utf-8",
+		"Title":        notification.Title,
+		"Priority":     ntfyPriority(notification.Priority),
+	}
+	if err := postNotification(ctx, ntfyTopicURL(cfg), []byte(strings.TrimSpace(notification.Message)), headers, http.StatusOK); err != nil {
+		return fmt.Errorf("ntfy publish failed: %w", err)
+	}
+	return nil
+}
+
+// ntfyTopicURL is where messages for the configured topic are published, e.g. https://ntfy.sh/my-team-alerts
+func ntfyTopicURL(cfg Config) string {
+	return strings.TrimSuffix(cfg.NtfyServer, "/") + "/" + cfg.NtfyTopic
+}
+
+// ntfyPriority maps a notification priority to ntfy's 1 (min) - 5 (max) scale
+func ntfyPriority(priority string) string {
+	switch priority {
+	case PriorityHigh:
+		return "4"
+	case PriorityLow:
+		return "2"
+	default:
+		return "3"
+	}
+}
+
+// Notification services rate limit with a 429 and a Retry-After. postNotificationJSON waits that out (capped, with a
+// little jitter so a batch of notifications doesn't all come back at once) a couple of times before giving up and
+// leaving it to the activity's retry policy.
+const (
+	notificationRateLimitRetries  = 2
+	defaultNotificationRetryAfter = time.Second // when the 429 doesn't say how long
+	maxNotificationRetryAfter     = 30 * time.Second
+	maxNotificationRetryJitter    = 500 * time.Millisecond
+)
+
+// waitForRetry sleeps for d unless ctx is done first. Swapped out in tests.
+var waitForRetry = func(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
+// postNotificationJSON POSTs payload as JSON to url. Any status not in okStatuses is an error, after retrying 429s.
+func postNotificationJSON(ctx context.Context, url string, payload any, okStatuses ...int) error {
+	jsonData, err := json.Marshal(payload)
 	if err != nil {
-		return fmt.Errorf("failed to create HTTP request: %w", err)
+		return fmt.Errorf("failed to marshal JSON: %w", err)
 	}
-	req.Header.Set("Content-Type", "application/json")
+	return postNotification(ctx, url, jsonData, map[string]string{"Content-Type": "application/json"}, okStatuses...)
+}
 
+// postNotification POSTs body to url with the given headers. Any status not in okStatuses is an error, after retrying 429s.
+func postNotification(ctx context.Context, url string, body []byte, headers map[string]string, okStatuses ...int) error {
 	client := &http.Client{}
-	resp, err := client.Do(req)
-	if err != nil {
-		return fmt.Errorf("failed to send HTTP request: %w", err)
+	for attempt := 0; ; attempt++ {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to create HTTP request: %w", err)
+		}
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return fmt.Errorf("failed to send HTTP request: %w", err)
+		}
+		resp.Body.Close()
+
+		if slices.Contains(okStatuses, resp.StatusCode) {
+			return nil
+		}
+		if resp.StatusCode != http.StatusTooManyRequests || attempt >= notificationRateLimitRetries {
+			return fmt.Errorf("received non-OK response: %s", resp.Status)
+		}
+
+		delay := retryAfter(resp.Header.Get("Retry-After"), time.Now()) + time.Duration(rand.Int63n(int64(maxNotificationRetryJitter)))
+		notificationLogger(ctx).Warn("Notification rate limited, retrying", "attempt", attempt+1, "retryAfter", delay)
+		if err := waitForRetry(ctx, delay); err != nil {
+			return fmt.Errorf("rate limited, gave up waiting to retry: %w", err)
+		}
 	}
-	defer resp.Body.Close()
+}
 
-	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusAccepted {
-		return fmt.Errorf("received non-OK response from Home Assistant: %s", resp.Status)
+// retryAfter reads a Retry-After header, either seconds or an HTTP date, capped at maxNotificationRetryAfter
+func retryAfter(header string, now time.Time) time.Duration {
+	delay := defaultNotificationRetryAfter
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		delay = time.Duration(seconds) * time.Second
+	} else if date, err := http.ParseTime(header); err == nil {
+		delay = max(date.Sub(now), 0)
 	}
-	return nil
+	return min(delay, maxNotificationRetryAfter)
+}
+
+// slackScoreCardBlocks lays a game notification out as Block Kit blocks: the matchup as a header,
+// then the notification title, score, and period, then the TV network and ESPN link underneath
+func slackScoreCardBlocks(notification Notification) []slack.Block {
+	card := notification.ScoreCard
+	header := slack.NewHeaderBlock(slack.NewTextBlockObject(slack.PlainTextType, fmt.Sprintf("%s vs %s", card.HomeTeam, card.AwayTeam), false, false))
+	section := slack.NewSectionBlock(
+		slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("*%s*\n*%s*\n%s", notification.Title, card.Score, card.Period), false, false),
+		nil, nil,
+	)
+	blocks := []slack.Block{header, section}
+
+	var contextElements []slack.MixedElement
+	if card.TVNetwork != "" {
+		contextElements = append(contextElements, slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf(":tv: %s", card.TVNetwork), false, false))
+	}
+	if card.GameURL != "" {
+		contextElements = append(contextElements, slack.NewTextBlockObject(slack.MarkdownType, fmt.Sprintf("<%s|Game on ESPN>", card.GameURL), false, false))
+	}
+	if len(contextElements) > 0 {
+		blocks = append(blocks, slack.NewContextBlock("", contextElements...))
+	}
+	return blocks
 }
 
+// SlackPartialDeliveryErrorType is returned by SendSlackNotification when some of the SLACK_CHANNEL_ID channels got
+// the message and some didn't
+const SlackPartialDeliveryErrorType = "SlackPartialDelivery"
+
+// slackAPIURL overrides the Slack Web API base URL (e.g. "http://127.0.0.1:1234/api/") - tests point it at an httptest server
+var slackAPIURL = ""
+
 // SendSlackNotificationActivity sends a notification to Slack
 func SendSlackNotification(ctx context.Context, notification Notification) error {
-	logger := activity.GetLogger(ctx)
+	logger := notificationLogger(ctx)
 	logger.Info("Sending Slack notification", "title", notification.Title, "message", notification.Message)
 
-	slackBotToken := os.Getenv("SLACK_BOT_TOKEN")
+	cfg := CurrentConfig()
+	slackBotToken := cfg.SlackBotToken
 	if slackBotToken == "" {
 		return fmt.Errorf("SLACK_BOT_TOKEN environment variable is not set")
 	}
 
-	slackChannelID := os.Getenv("SLACK_CHANNEL_ID")
-	if slackChannelID == "" {
+	slackChannelIDs := cfg.SlackChannelID
+	if slackChannelIDs == "" {
 		return fmt.Errorf("SLACK_CHANNEL_ID environment variable is not set")
 	}
 
-	api := slack.New(slackBotToken)
+	var options []slack.Option
+	if slackAPIURL != "" {
+		options = append(options, slack.OptionAPIURL(slackAPIURL))
+	}
+	api := slack.New(slackBotToken, options...)
+	// Attachment titles are plain text, so the (bold) title goes at the top of the mrkdwn text instead
+	title, body := notification.FormatFor("slack")
 	attachment := slack.Attachment{
-		Title: 	notification.Title,
-		Text:   notification.Message,
-		Color:  "#444CE7", // Temporal UV
+		Text:       strings.TrimSpace(title + "\n" + body),
+		Color:      "#444CE7", // Temporal UV
+		MarkdownIn: []string{"text"},
 	}
 
-	_, _, err := api.PostMessage(
-		slackChannelID,
-		slack.MsgOptionAttachments(attachment),
-	)
-	if err != nil {
-		return fmt.Errorf("failed to send Slack message: %w", err)
+	messageOption := slack.MsgOptionAttachments(attachment)
+	if cfg.SlackUseBlocks && notification.ScoreCard != nil {
+		// Title doubles as the fallback text for notifications and clients that can't show blocks
+		messageOption = slack.MsgOptionCompose(
+			slack.MsgOptionText(notification.Title, false),
+			slack.MsgOptionBlocks(slackScoreCardBlocks(notification)...),
+		)
 	}
-	return nil
+
+	// SLACK_CHANNEL_ID can be a comma-separated list - send to all of them, and report every channel that failed
+	var errs []error
+	sent := 0
+	for _, slackChannelID := range strings.Split(slackChannelIDs, ",") {
+		slackChannelID = strings.TrimSpace(slackChannelID)
+		if slackChannelID == "" {
+			continue
+		}
+		_, _, err := api.PostMessageContext(
+			ctx,
+			slackChannelID,
+			messageOption,
+		)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to send Slack message to %s: %w", slackChannelID, err))
+			continue
+		}
+		sent++
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	if sent > 0 {
+		// A retry would post again to the channels that already have it, so only retry when nothing got through
+		return temporal.NewNonRetryableApplicationError(errors.Join(errs...).Error(), SlackPartialDeliveryErrorType, nil)
+	}
+	return errors.Join(errs...)
 }
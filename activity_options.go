@@ -0,0 +1,200 @@
+package sports
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// Default StartToClose timeouts. Fetching games can hit ESPN once per conference, so it gets a lot more room
+// than a single score fetch or notification send. Starting a game workflow is one call to the Temporal server, so a
+// slow one is cut off and retried quickly - that's safe, since a start that did go through is found by its workflow ID.
+const (
+	DefaultGetGamesTimeout          = 2 * time.Minute
+	DefaultGetGameScoreTimeout      = 30 * time.Second
+	DefaultStartGameWorkflowTimeout = 10 * time.Second
+	DefaultNotificationTimeout      = 15 * time.Second
+)
+
+// ActivityTimeouts holds the StartToClose timeout for each kind of activity. Zero means use the default.
+// These get resolved outside the workflow (see ActivityTimeoutsFromEnv) and passed in, since workflows can't read env vars.
+type ActivityTimeouts struct {
+	GetGames          time.Duration `json:"getGames,omitempty"`
+	GetGameScore      time.Duration `json:"getGameScore,omitempty"`
+	StartGameWorkflow time.Duration `json:"startGameWorkflow,omitempty"`
+	Notification      time.Duration `json:"notification,omitempty"`
+}
+
+// ActivityTimeoutsFromEnv reads the ACTIVITY_TIMEOUT_* env vars, which take Go durations like "90s" or "2m".
+// Unset vars are left at zero so the defaults apply.
+func ActivityTimeoutsFromEnv() (ActivityTimeouts, error) {
+	var timeouts ActivityTimeouts
+	envVars := []struct {
+		name  string
+		value *time.Duration
+	}{
+		{"ACTIVITY_TIMEOUT_GET_GAMES", &timeouts.GetGames},
+		{"ACTIVITY_TIMEOUT_GET_GAME_SCORE", &timeouts.GetGameScore},
+		{"ACTIVITY_TIMEOUT_START_GAME_WORKFLOW", &timeouts.StartGameWorkflow},
+		{"ACTIVITY_TIMEOUT_NOTIFICATION", &timeouts.Notification},
+	}
+	for _, envVar := range envVars {
+		str := os.Getenv(envVar.name)
+		if str == "" {
+			continue
+		}
+		d, err := time.ParseDuration(str)
+		if err != nil || d <= 0 {
+			return ActivityTimeouts{}, fmt.Errorf("invalid %s %q: must be a positive duration like \"90s\"", envVar.name, str)
+		}
+		*envVar.value = d
+	}
+	return timeouts, nil
+}
+
+// withDefaults fills in any unset timeouts
+func (t ActivityTimeouts) withDefaults() ActivityTimeouts {
+	if t.GetGames <= 0 {
+		t.GetGames = DefaultGetGamesTimeout
+	}
+	if t.GetGameScore <= 0 {
+		t.GetGameScore = DefaultGetGameScoreTimeout
+	}
+	if t.StartGameWorkflow <= 0 {
+		t.StartGameWorkflow = DefaultStartGameWorkflowTimeout
+	}
+	if t.Notification <= 0 {
+		t.Notification = DefaultNotificationTimeout
+	}
+	return t
+}
+
+// Default retry policy. CollectGamesWorkflow gives up sooner than GameWorkflow, which has a whole game to get through.
+// Starting a game workflow can be retried more than anything else, since a repeat start doesn't start it twice.
+const (
+	DefaultCollectMaximumAttempts   = 3
+	DefaultGameMaximumAttempts      = 5
+	DefaultStartGameMaximumAttempts = 10
+	DefaultRetryInitialInterval     = time.Second
+	DefaultRetryBackoff             = 2.0
+	DefaultRetryMaximumInterval     = 30 * time.Second
+)
+
+// Bounds for the ACTIVITY_RETRY_* env vars, so a typo can't make an activity retry forever or hammer ESPN
+const (
+	maxRetryAttempts        = 20
+	minRetryInitialInterval = 100 * time.Millisecond
+	maxRetryInterval        = 10 * time.Minute
+	maxRetryBackoff         = 10.0
+)
+
+// ActivityRetry tunes the retry policy on every activity. Zero means use the default.
+// Like ActivityTimeouts, it's resolved outside the workflow (see ActivityRetryFromEnv) and passed in.
+type ActivityRetry struct {
+	CollectMaximumAttempts   int32         `json:"collectMaximumAttempts,omitempty"`   // CollectGamesWorkflow's activities, other than starting games
+	GameMaximumAttempts      int32         `json:"gameMaximumAttempts,omitempty"`      // GameWorkflow's activities
+	StartGameMaximumAttempts int32         `json:"startGameMaximumAttempts,omitempty"` // StartGameWorkflowActivity, which CollectGamesWorkflow runs
+	InitialInterval          time.Duration `json:"initialInterval,omitempty"`
+	BackoffCoefficient       float64       `json:"backoffCoefficient,omitempty"`
+	MaximumInterval          time.Duration `json:"maximumInterval,omitempty"`
+}
+
+// ActivityRetryFromEnv reads the ACTIVITY_RETRY_* env vars and checks they're within sane bounds.
+// Unset vars are left at zero so the defaults apply.
+func ActivityRetryFromEnv() (ActivityRetry, error) {
+	var retry ActivityRetry
+	attemptVars := []struct {
+		name  string
+		value *int32
+	}{
+		{"ACTIVITY_RETRY_COLLECT_MAX_ATTEMPTS", &retry.CollectMaximumAttempts},
+		{"ACTIVITY_RETRY_GAME_MAX_ATTEMPTS", &retry.GameMaximumAttempts},
+		{"ACTIVITY_RETRY_START_GAME_MAX_ATTEMPTS", &retry.StartGameMaximumAttempts},
+	}
+	for _, envVar := range attemptVars {
+		str := os.Getenv(envVar.name)
+		if str == "" {
+			continue
+		}
+		n, err := strconv.Atoi(str)
+		if err != nil || n < 1 || n > maxRetryAttempts {
+			return ActivityRetry{}, fmt.Errorf("invalid %s %q: must be a number from 1 to %d", envVar.name, str, maxRetryAttempts)
+		}
+		*envVar.value = int32(n)
+	}
+
+	intervalVars := []struct {
+		name  string
+		value *time.Duration
+	}{
+		{"ACTIVITY_RETRY_INITIAL_INTERVAL", &retry.InitialInterval},
+		{"ACTIVITY_RETRY_MAX_INTERVAL", &retry.MaximumInterval},
+	}
+	for _, envVar := range intervalVars {
+		str := os.Getenv(envVar.name)
+		if str == "" {
+			continue
+		}
+		d, err := time.ParseDuration(str)
+		if err != nil || d < minRetryInitialInterval || d > maxRetryInterval {
+			return ActivityRetry{}, fmt.Errorf("invalid %s %q: must be a duration from %s to %s", envVar.name, str, minRetryInitialInterval, maxRetryInterval)
+		}
+		*envVar.value = d
+	}
+
+	if str := os.Getenv("ACTIVITY_RETRY_BACKOFF"); str != "" {
+		backoff, err := strconv.ParseFloat(str, 64)
+		if err != nil || backoff < 1 || backoff > maxRetryBackoff {
+			return ActivityRetry{}, fmt.Errorf("invalid ACTIVITY_RETRY_BACKOFF %q: must be a number from 1 to %g", str, maxRetryBackoff)
+		}
+		retry.BackoffCoefficient = backoff
+	}
+
+	// Check the two intervals against each other with the defaults filled in, since only one might be set
+	resolved := retry.withDefaults()
+	if resolved.MaximumInterval < resolved.InitialInterval {
+		return ActivityRetry{}, fmt.Errorf("ACTIVITY_RETRY_MAX_INTERVAL (%s) can't be less than ACTIVITY_RETRY_INITIAL_INTERVAL (%s)", resolved.MaximumInterval, resolved.InitialInterval)
+	}
+	return retry, nil
+}
+
+// withDefaults fills in any unset retry settings
+func (r ActivityRetry) withDefaults() ActivityRetry {
+	if r.CollectMaximumAttempts <= 0 {
+		r.CollectMaximumAttempts = DefaultCollectMaximumAttempts
+	}
+	if r.GameMaximumAttempts <= 0 {
+		r.GameMaximumAttempts = DefaultGameMaximumAttempts
+	}
+	if r.StartGameMaximumAttempts <= 0 {
+		r.StartGameMaximumAttempts = DefaultStartGameMaximumAttempts
+	}
+	if r.InitialInterval <= 0 {
+		r.InitialInterval = DefaultRetryInitialInterval
+	}
+	if r.BackoffCoefficient < 1 {
+		r.BackoffCoefficient = DefaultRetryBackoff
+	}
+	if r.MaximumInterval <= 0 {
+		r.MaximumInterval = DefaultRetryMaximumInterval
+	}
+	return r
+}
+
+// newActivityOptions builds the activity options (timeout plus retry policy) for one kind of activity.
+// retry should already have its defaults filled in.
+func newActivityOptions(startToCloseTimeout time.Duration, maximumAttempts int32, retry ActivityRetry) workflow.ActivityOptions {
+	return workflow.ActivityOptions{
+		StartToCloseTimeout: startToCloseTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			InitialInterval:    retry.InitialInterval,
+			BackoffCoefficient: retry.BackoffCoefficient,
+			MaximumInterval:    retry.MaximumInterval,
+			MaximumAttempts:    maximumAttempts,
+		},
+	}
+}
@@ -0,0 +1,245 @@
+package sports
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func TestActivityTimeouts_Defaults(t *testing.T) {
+	timeouts := ActivityTimeouts{}.withDefaults()
+
+	retry := ActivityRetry{}.withDefaults()
+	getGamesOptions := newActivityOptions(timeouts.GetGames, retry.CollectMaximumAttempts, retry)
+	notificationOptions := newActivityOptions(timeouts.Notification, retry.GameMaximumAttempts, retry)
+
+	assert.Equal(t, DefaultGetGamesTimeout, getGamesOptions.StartToCloseTimeout)
+	assert.Equal(t, DefaultNotificationTimeout, notificationOptions.StartToCloseTimeout)
+	assert.Greater(t, getGamesOptions.StartToCloseTimeout, notificationOptions.StartToCloseTimeout)
+	assert.Equal(t, int32(3), getGamesOptions.RetryPolicy.MaximumAttempts)
+}
+
+func TestActivityTimeouts_Overrides(t *testing.T) {
+	timeouts := ActivityTimeouts{GetGames: 5 * time.Minute}.withDefaults()
+
+	assert.Equal(t, 5*time.Minute, timeouts.GetGames)
+	assert.Equal(t, DefaultGetGameScoreTimeout, timeouts.GetGameScore)
+	assert.Equal(t, DefaultStartGameWorkflowTimeout, timeouts.StartGameWorkflow)
+	assert.Equal(t, DefaultNotificationTimeout, timeouts.Notification)
+}
+
+func TestActivityTimeoutsFromEnv(t *testing.T) {
+	tests := []struct {
+		name          string
+		env           map[string]string
+		expected      ActivityTimeouts
+		expectedError bool
+	}{
+		{
+			name:     "nothing set",
+			expected: ActivityTimeouts{},
+		},
+		{
+			name: "some set",
+			env: map[string]string{
+				"ACTIVITY_TIMEOUT_GET_GAMES":    "3m",
+				"ACTIVITY_TIMEOUT_NOTIFICATION": "10s",
+			},
+			expected: ActivityTimeouts{GetGames: 3 * time.Minute, Notification: 10 * time.Second},
+		},
+		{
+			name:          "not a duration",
+			env:           map[string]string{"ACTIVITY_TIMEOUT_GET_GAME_SCORE": "30"},
+			expectedError: true,
+		},
+		{
+			name:          "negative",
+			env:           map[string]string{"ACTIVITY_TIMEOUT_START_GAME_WORKFLOW": "-5s"},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"ACTIVITY_TIMEOUT_GET_GAMES", "ACTIVITY_TIMEOUT_GET_GAME_SCORE", "ACTIVITY_TIMEOUT_START_GAME_WORKFLOW", "ACTIVITY_TIMEOUT_NOTIFICATION"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			timeouts, err := ActivityTimeoutsFromEnv()
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, timeouts)
+		})
+	}
+}
+
+func TestCollectGamesWorkflow_GetGamesTimeout(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var startToClose time.Duration
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, req TrackingRequest) ([]Game, error) {
+		info := activity.GetInfo(ctx)
+		startToClose = info.Deadline.Sub(info.StartedTime)
+		return []Game{}, nil
+	})
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{Sport: "football", League: "college-football"})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, DefaultGetGamesTimeout, startToClose)
+}
+
+func TestActivityRetry_Options(t *testing.T) {
+	retry := ActivityRetry{CollectMaximumAttempts: 7, GameMaximumAttempts: 2, MaximumInterval: time.Minute}.withDefaults()
+
+	collectOptions := newActivityOptions(DefaultGetGamesTimeout, retry.CollectMaximumAttempts, retry)
+	gameOptions := newActivityOptions(DefaultGetGameScoreTimeout, retry.GameMaximumAttempts, retry)
+
+	assert.Equal(t, int32(7), collectOptions.RetryPolicy.MaximumAttempts)
+	assert.Equal(t, int32(2), gameOptions.RetryPolicy.MaximumAttempts)
+	assert.Equal(t, time.Minute, gameOptions.RetryPolicy.MaximumInterval)
+	// Unset ones keep the defaults
+	assert.Equal(t, DefaultRetryInitialInterval, gameOptions.RetryPolicy.InitialInterval)
+	assert.Equal(t, DefaultRetryBackoff, gameOptions.RetryPolicy.BackoffCoefficient)
+
+	defaults := ActivityRetry{}.withDefaults()
+	assert.Equal(t, int32(DefaultCollectMaximumAttempts), defaults.CollectMaximumAttempts)
+	assert.Equal(t, int32(DefaultGameMaximumAttempts), defaults.GameMaximumAttempts)
+	assert.Equal(t, int32(DefaultStartGameMaximumAttempts), defaults.StartGameMaximumAttempts)
+}
+
+func TestActivityRetryFromEnv(t *testing.T) {
+	tests := []struct {
+		name          string
+		env           map[string]string
+		expected      ActivityRetry
+		expectedError bool
+	}{
+		{
+			name:     "nothing set",
+			expected: ActivityRetry{},
+		},
+		{
+			name: "all set",
+			env: map[string]string{
+				"ACTIVITY_RETRY_COLLECT_MAX_ATTEMPTS":    "4",
+				"ACTIVITY_RETRY_GAME_MAX_ATTEMPTS":       "8",
+				"ACTIVITY_RETRY_START_GAME_MAX_ATTEMPTS": "12",
+				"ACTIVITY_RETRY_INITIAL_INTERVAL":        "2s",
+				"ACTIVITY_RETRY_MAX_INTERVAL":            "1m",
+				"ACTIVITY_RETRY_BACKOFF":                 "1.5",
+			},
+			expected: ActivityRetry{CollectMaximumAttempts: 4, GameMaximumAttempts: 8, StartGameMaximumAttempts: 12, InitialInterval: 2 * time.Second, MaximumInterval: time.Minute, BackoffCoefficient: 1.5},
+		},
+		{
+			name:          "zero attempts",
+			env:           map[string]string{"ACTIVITY_RETRY_GAME_MAX_ATTEMPTS": "0"},
+			expectedError: true,
+		},
+		{
+			name:          "too many attempts",
+			env:           map[string]string{"ACTIVITY_RETRY_COLLECT_MAX_ATTEMPTS": "1000"},
+			expectedError: true,
+		},
+		{
+			name:          "interval too short",
+			env:           map[string]string{"ACTIVITY_RETRY_INITIAL_INTERVAL": "1ms"},
+			expectedError: true,
+		},
+		{
+			name:          "backoff below 1",
+			env:           map[string]string{"ACTIVITY_RETRY_BACKOFF": "0.5"},
+			expectedError: true,
+		},
+		{
+			name:          "max interval below the default initial interval",
+			env:           map[string]string{"ACTIVITY_RETRY_MAX_INTERVAL": "500ms"},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, name := range []string{"ACTIVITY_RETRY_COLLECT_MAX_ATTEMPTS", "ACTIVITY_RETRY_GAME_MAX_ATTEMPTS", "ACTIVITY_RETRY_START_GAME_MAX_ATTEMPTS", "ACTIVITY_RETRY_INITIAL_INTERVAL", "ACTIVITY_RETRY_MAX_INTERVAL", "ACTIVITY_RETRY_BACKOFF"} {
+				t.Setenv(name, tt.env[name])
+			}
+
+			retry, err := ActivityRetryFromEnv()
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, retry)
+		})
+	}
+}
+
+func TestCollectGamesWorkflow_RetryAttempts(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	attempts := 0
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, req TrackingRequest) ([]Game, error) {
+		attempts++
+		return nil, assert.AnError
+	})
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{
+		Sport:         "football",
+		League:        "college-football",
+		ActivityRetry: ActivityRetry{CollectMaximumAttempts: 2},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.Error(t, env.GetWorkflowError())
+	assert.Equal(t, 2, attempts)
+}
+
+func TestCollectGamesWorkflow_StartGameRetriedAfterTimeout(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return([]Game{
+		{ID: "401520281", Status: "pre", StartTime: workflowStart.Add(time.Hour)},
+	}, nil)
+
+	var startToCloses []time.Duration
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) error {
+		info := activity.GetInfo(ctx)
+		startToCloses = append(startToCloses, info.Deadline.Sub(info.StartedTime))
+		if info.Attempt == 1 {
+			// What startGameWorkflow returns when the client call runs out of time
+			return fmt.Errorf("unable to execute workflow: %w", context.DeadlineExceeded)
+		}
+		// startGameWorkflow treats the game workflow the first attempt started as a success
+		return nil
+	})
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{
+		Sport:  "football",
+		League: "college-football",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result CollectionResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, 1, result.ScheduledGames)
+	assert.Equal(t, []time.Duration{DefaultStartGameWorkflowTimeout, DefaultStartGameWorkflowTimeout}, startToCloses)
+}
@@ -5,7 +5,6 @@ import (
 	"crypto/tls"
 	"log/slog"
 	"os"
-	"github.com/joho/godotenv"
 
 	"go.temporal.io/sdk/client"
 	tlog "go.temporal.io/sdk/log"
@@ -13,33 +12,22 @@ import (
 	"google.golang.org/grpc/metadata"
 )
 
+// GetClientOptions loads the config and builds client options from it, exiting if the config is invalid
 func GetClientOptions() client.Options {
-	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
-		Level: slog.LevelInfo,
-	}))
-	slog.SetDefault(logger)
-
-	err := godotenv.Load()
+	cfg, err := LoadConfig()
 	if err != nil {
-		logger.Warn("No .env file found, relying on environment variables")
-	}	
-
-	TemporalAddress := os.Getenv("TEMPORAL_HOST")
-	if TemporalAddress == "" {
-		slog.Error("TEMPORAL_HOST environment variable is not set")
-		os.Exit(1)
-	}
-
-	TemporalNamespace := os.Getenv("TEMPORAL_NAMESPACE")
-	if TemporalNamespace == "" {
-		slog.Error("TEMPORAL_NAMESPACE environment variable is not set")
+		slog.Error("Invalid configuration", "error", err)
 		os.Exit(1)
 	}
+	return NewClientOptions(cfg)
+}
 
+// NewClientOptions builds Temporal client options for an already loaded config
+func NewClientOptions(cfg Config) client.Options {
 	clientOptions := client.Options{
-		HostPort:  TemporalAddress,
-		Namespace: TemporalNamespace,
-		Logger:    tlog.NewStructuredLogger(logger),
+		HostPort:  cfg.TemporalHost,
+		Namespace: cfg.TemporalNamespace,
+		Logger:    tlog.NewStructuredLogger(slog.Default()),
 	}
 
 	clientOptions.ConnectionOptions = client.ConnectionOptions{
@@ -48,7 +36,7 @@ func GetClientOptions() client.Options {
 			grpc.WithUnaryInterceptor(
 				func(ctx context.Context, method string, req any, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 					return invoker(
-						metadata.AppendToOutgoingContext(ctx, "temporal-namespace", TemporalNamespace),
+						metadata.AppendToOutgoingContext(ctx, "temporal-namespace", cfg.TemporalNamespace),
 						method,
 						req,
 						reply,
@@ -60,14 +48,8 @@ func GetClientOptions() client.Options {
 		},
 	}
 
-	if TemporalAddress != "localhost:7233" && TemporalAddress != "temporal:7233" {
-		TemporalAPIKey := os.Getenv("TEMPORAL_API_KEY")
-		if TemporalAPIKey == "" {
-			slog.Error("TEMPORAL_API_KEY environment variable is not set")
-			os.Exit(1)
-		}
-
-		clientOptions.Credentials = client.NewAPIKeyStaticCredentials(TemporalAPIKey)
+	if !cfg.LocalTemporal() {
+		clientOptions.Credentials = client.NewAPIKeyStaticCredentials(cfg.TemporalAPIKey)
 	} else {
 		clientOptions.ConnectionOptions.TLS = nil // Disable TLS for local development
 	}
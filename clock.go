@@ -0,0 +1,37 @@
+package sports
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// errNoClock means ESPN isn't showing a clock right now (before the game, halftime, between periods)
+var errNoClock = errors.New("no game clock")
+
+// parseClock turns ESPN's display clock into how much time is left in the period. ESPN shows "MM:SS" for most of a
+// period and switches to "SS.s" (e.g. "45.3") in the last minute of basketball and hockey, and some feeds mix the two ("0:45.3").
+func parseClock(display string) (time.Duration, error) {
+	display = strings.TrimSpace(display)
+	if display == "" || strings.EqualFold(display, "halftime") {
+		return 0, errNoClock
+	}
+
+	minutesStr, secondsStr, found := strings.Cut(display, ":")
+	if !found {
+		minutesStr, secondsStr = "0", display
+	}
+
+	minutes, err := strconv.Atoi(minutesStr)
+	if err != nil || minutes < 0 {
+		return 0, fmt.Errorf("unrecognized clock %q", display)
+	}
+	seconds, err := strconv.ParseFloat(secondsStr, 64)
+	if err != nil || seconds < 0 || seconds >= 60 {
+		return 0, fmt.Errorf("unrecognized clock %q", display)
+	}
+	return time.Duration(minutes)*time.Minute + time.Duration(math.Round(seconds*1000))*time.Millisecond, nil
+}
@@ -0,0 +1,45 @@
+package sports
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseClock(t *testing.T) {
+	tests := []struct {
+		name        string
+		display     string
+		expected    time.Duration
+		wantErr     bool
+		wantNoClock bool
+	}{
+		{name: "minutes and seconds", display: "12:34", expected: 12*time.Minute + 34*time.Second},
+		{name: "single digit minutes", display: "1:45", expected: time.Minute + 45*time.Second},
+		{name: "zero", display: "0:00", expected: 0},
+		{name: "tenths with minutes", display: "0:45.3", expected: 45*time.Second + 300*time.Millisecond},
+		{name: "tenths only", display: "45.3", expected: 45*time.Second + 300*time.Millisecond},
+		{name: "whole seconds only", display: "9", expected: 9 * time.Second},
+		{name: "empty", display: "", wantErr: true, wantNoClock: true},
+		{name: "halftime", display: "Halftime", wantErr: true, wantNoClock: true},
+		{name: "soccer minute", display: "67'", wantErr: true},
+		{name: "seconds out of range", display: "1:75", wantErr: true},
+		{name: "malformed", display: "12:ab", wantErr: true},
+		{name: "negative", display: "-1:00", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock, err := parseClock(tt.display)
+			if tt.wantErr {
+				require.Error(t, err)
+				assert.Equal(t, tt.wantNoClock, err == errNoClock)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, clock)
+		})
+	}
+}
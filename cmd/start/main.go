@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"strings"
+
+	sports "temporal-sports-tracker"
+
+	"go.temporal.io/sdk/client"
+)
+
+// start kicks off a CollectGamesWorkflow from the command line, for when the web UI isn't running, e.g.
+//
+//	go run ./cmd/start -sport football -league college-football -conferences "Big Ten,SEC"
+func main() {
+	sport := flag.String("sport", "", "ESPN sport, e.g. football (required)")
+	league := flag.String("league", "", "ESPN league, e.g. college-football (required)")
+	teams := flag.String("teams", "", "comma-separated team IDs or names, e.g. 130,MICH")
+	conferences := flag.String("conferences", "", "comma-separated conference group IDs or names, e.g. 5,SEC")
+	flag.Parse()
+
+	req, err := trackingRequestFromFlags(*sport, *league, *teams, *conferences)
+	if err != nil {
+		flag.Usage()
+		log.Fatalln(err)
+	}
+
+	cfg, err := sports.LoadConfig()
+	if err != nil {
+		log.Fatalln("Invalid configuration:", err)
+	}
+	if cfg.TaskQueue == "" {
+		log.Fatalln("TASK_QUEUE environment variable is not set")
+	}
+
+	c, err := client.Dial(sports.NewClientOptions(cfg))
+	if err != nil {
+		log.Fatalln("Unable to create Temporal client", err)
+	}
+	defer c.Close()
+
+	we, err := sports.StartCollection(context.Background(), c, cfg, req)
+	if err != nil {
+		log.Fatalln("Unable to start workflow", err)
+	}
+	log.Println("Started workflow", "WorkflowID", we.GetID(), "RunID", we.GetRunID())
+}
+
+// trackingRequestFromFlags builds the TrackingRequest for the flags. Sport and league are required; with neither
+// teams nor conferences the configured default conferences (CONFERENCES) are used, if there are any.
+func trackingRequestFromFlags(sport string, league string, teams string, conferences string) (sports.TrackingRequest, error) {
+	sport = strings.TrimSpace(sport)
+	league = strings.TrimSpace(league)
+	if sport == "" || league == "" {
+		return sports.TrackingRequest{}, errors.New("-sport and -league are required")
+	}
+	return sports.TrackingRequest{
+		Sport:       sport,
+		League:      league,
+		Teams:       splitList(teams),
+		Conferences: splitList(conferences),
+	}, nil
+}
+
+// splitList splits a comma-separated flag value, dropping blanks
+func splitList(value string) []string {
+	var items []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/mocks"
+
+	sports "temporal-sports-tracker"
+)
+
+func TestTrackingRequestFromFlags(t *testing.T) {
+	tests := []struct {
+		name        string
+		sport       string
+		league      string
+		teams       string
+		conferences string
+		expected    sports.TrackingRequest
+		expectedErr bool
+	}{
+		{
+			name:     "teams",
+			sport:    "football",
+			league:   "college-football",
+			teams:    "130, MICH,,194",
+			expected: sports.TrackingRequest{Sport: "football", League: "college-football", Teams: []string{"130", "MICH", "194"}},
+		},
+		{
+			name:        "conferences",
+			sport:       "basketball",
+			league:      "mens-college-basketball",
+			conferences: "7,Big East",
+			expected:    sports.TrackingRequest{Sport: "basketball", League: "mens-college-basketball", Conferences: []string{"7", "Big East"}},
+		},
+		{
+			name:     "neither, for the configured default conferences",
+			sport:    "football",
+			league:   "nfl",
+			expected: sports.TrackingRequest{Sport: "football", League: "nfl"},
+		},
+		{name: "no sport", league: "nfl", expectedErr: true},
+		{name: "no league", sport: "football", teams: "130", expectedErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req, err := trackingRequestFromFlags(tt.sport, tt.league, tt.teams, tt.conferences)
+			if tt.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, req)
+		})
+	}
+}
+
+func TestStartCollection(t *testing.T) {
+	req, err := trackingRequestFromFlags("football", "college-football", "130,194", "")
+	require.NoError(t, err)
+
+	// CollectGamesWorkflow takes the request as its only argument - starting it without one fails on the worker
+	run := mocks.NewWorkflowRun(t)
+	c := mocks.NewClient(t)
+	c.On("ExecuteWorkflow", mock.Anything,
+		mock.MatchedBy(func(options client.StartWorkflowOptions) bool {
+			return options.TaskQueue == "sports-tracker-task-queue" && strings.HasPrefix(options.ID, "sports-")
+		}),
+		mock.MatchedBy(func(workflow any) bool {
+			return reflect.ValueOf(workflow).Pointer() == reflect.ValueOf(sports.CollectGamesWorkflow).Pointer()
+		}),
+		sports.TrackingRequest{Sport: "football", League: "college-football", Teams: []string{"130", "194"}},
+	).Return(run, nil).Once()
+
+	we, err := sports.StartCollection(context.Background(), c, sports.Config{TaskQueue: "sports-tracker-task-queue"}, req)
+	require.NoError(t, err)
+	assert.Equal(t, run, we)
+}
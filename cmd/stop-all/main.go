@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"log"
+	sports "temporal-sports-tracker"
+
+	"go.temporal.io/sdk/client"
+)
+
+// stop-all is the emergency stop: it signals every running GameWorkflow to stop tracking and finish normally
+func main() {
+	cfg, err := sports.LoadConfig()
+	if err != nil {
+		log.Fatalln("Invalid configuration:", err)
+	}
+
+	c, err := client.Dial(sports.NewClientOptions(cfg))
+	if err != nil {
+		log.Fatalln("Unable to create Temporal client", err)
+	}
+	defer c.Close()
+
+	stopped, err := sports.StopAllGames(context.Background(), c)
+	log.Printf("Asked %d game workflows to stop tracking", stopped)
+	if err != nil {
+		log.Fatalln("Some game workflows couldn't be stopped:", err)
+	}
+}
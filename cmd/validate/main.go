@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	sports "temporal-sports-tracker"
+	"time"
+)
+
+// validateTimeout is how long all the channel checks together get
+const validateTimeout = 30 * time.Second
+
+// validate checks the configured notification channels' credentials without sending anything, so a bad token
+// turns up before game day rather than during it
+func main() {
+	cfg, err := sports.LoadConfig()
+	if err != nil {
+		log.Fatalln("Invalid configuration:", err)
+	}
+	sports.SetConfig(cfg)
+
+	// An unreachable channel shouldn't leave this hanging
+	ctx, cancel := context.WithTimeout(context.Background(), validateTimeout)
+	defer cancel()
+
+	statuses, err := sports.ValidateNotificationConfig(ctx, cfg.NotificationChannels)
+	for _, status := range statuses {
+		if status.OK {
+			fmt.Printf("%-10s ok\n", status.Channel)
+		} else {
+			fmt.Printf("%-10s FAILED: %s\n", status.Channel, status.Error)
+		}
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
@@ -11,11 +12,17 @@ import (
 )
 
 func main() {
+	// Load and check the config once - the handlers pick it up from here
+	cfg, err := sports.LoadConfig()
+	if err != nil {
+		log.Fatalln("Invalid configuration:", err)
+	}
+	sports.SetConfig(cfg)
+
 	// Create Temporal client
 	var temporalClient client.Client
-	var err error
-	
-	temporalClient, err = client.Dial(sports.GetClientOptions())
+
+	temporalClient, err = client.Dial(sports.NewClientOptions(cfg))
 	if err != nil {
 		log.Printf("Warning: Unable to create Temporal client: %v", err)
 		log.Printf("The UI will work but workflow operations will be limited")
@@ -27,6 +34,12 @@ func main() {
 
 	// Create web handlers with Temporal client (can be nil)
 	handlers := web.NewHandlers(temporalClient)
+	if err := handlers.Validate(); err != nil {
+		log.Fatalln("Invalid configuration:", err)
+	}
+
+	// Conferences for the college leagues come from ESPN, loaded now and refreshed daily
+	go handlers.RefreshConferences(context.Background())
 
 	// Serve static files
 	staticDir := "web/static"
@@ -38,14 +51,8 @@ func main() {
 	fs := http.FileServer(http.Dir(staticDir))
 	http.Handle("/", fs)
 
-	// API routes
-	http.HandleFunc("/api/sports", handlers.GetSports)
-	http.HandleFunc("/api/leagues/", handlers.GetLeagues)
-	http.HandleFunc("/api/teams/", handlers.GetTeams)
-	http.HandleFunc("/api/conferences/", handlers.GetConferences)
-	http.HandleFunc("/api/track", handlers.StartTracking)
-	http.HandleFunc("/api/workflows", handlers.GetWorkflows)
-	http.HandleFunc("/api/workflows/", handlers.ManageWorkflow)
+	// API routes, under /api/v1/ with /api/ kept as an alias
+	http.Handle("/api/", handlers.Routes())
 
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -1,52 +1,280 @@
 package sports
 
 import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
 	"time"
 
-	"go.temporal.io/sdk/temporal"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/sdk/workflow"
 )
 
-// CollectGamesWorkflow collects all games based on input and schedules each game as a GameWorkflow
-func CollectGamesWorkflow(ctx workflow.Context, trackingRequest TrackingRequest) (int, error) {
+// AddGameSignal pushes a Game into a running (polling) CollectGamesWorkflow to be scheduled right away
+const AddGameSignal = "addGame"
+
+const (
+	defaultMaxEmptyPolls = 3
+	// Schedule at most this many games per collection, so a request with no real filter can't start hundreds of workflows
+	defaultMaxGames = 100
+	// Polls per run before we Continue-As-New, to keep history from growing all season
+	maxPollsPerRun = 100
+)
+
+// CollectGamesWorkflow collects all games based on input and schedules each game as a GameWorkflow.
+// With a PollInterval set it keeps re-checking ESPN for new games until MaxEmptyPolls fetches in a row come back empty,
+// and schedules games sent with the addGame signal in between polls.
+func CollectGamesWorkflow(ctx workflow.Context, trackingRequest TrackingRequest) (CollectionResult, error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting Collect Games Workflow.")
 
-	// Set up activity options with retry policy
-	activityOptions := workflow.ActivityOptions{
-		StartToCloseTimeout: 30 * time.Second,
-		RetryPolicy: &temporal.RetryPolicy{
-			InitialInterval:    time.Second,
-			BackoffCoefficient: 2.0,
-			MaximumInterval:    30 * time.Second,
-			MaximumAttempts:    3,
-		},
-	}
-	ctx = workflow.WithActivityOptions(ctx, activityOptions)
-
-	// Fetch games from ESPN API
-	var games []Game
-	err := workflow.ExecuteActivity(ctx, GetGamesActivity, trackingRequest).Get(ctx, &games)
+	// Set up activity options with retry policy, with a separate timeout for each activity
+	timeouts := trackingRequest.ActivityTimeouts.withDefaults()
+	retry := trackingRequest.ActivityRetry.withDefaults()
+	getGamesCtx := workflow.WithActivityOptions(ctx, newActivityOptions(timeouts.GetGames, retry.CollectMaximumAttempts, retry))
+	startGameCtx := workflow.WithActivityOptions(ctx, newActivityOptions(timeouts.StartGameWorkflow, retry.StartGameMaximumAttempts, retry))
+	notifyCtx := workflow.WithActivityOptions(ctx, newActivityOptions(timeouts.Notification, retry.CollectMaximumAttempts, retry))
+
+	maxEmptyPolls := trackingRequest.MaxEmptyPolls
+	if maxEmptyPolls <= 0 {
+		maxEmptyPolls = defaultMaxEmptyPolls
+	}
+
+	maxGames := trackingRequest.MaxGames
+	if maxGames <= 0 {
+		maxGames = defaultMaxGames
+	}
+
+	// Query handler so the UI can link a collection to its games - the GameWorkflow IDs it's started, including in
+	// earlier runs, so games aren't announced or counted against maxGames again after a Continue-As-New
+	scheduledGames := trackingRequest.ScheduledGames
+	err := workflow.SetQueryHandler(ctx, "scheduledGames", func() ([]string, error) {
+		return scheduledGames, nil
+	})
 	if err != nil {
-		logger.Error("Failed to fetch games", "error", err)
-		return 0, err
+		logger.Error("Failed to set query handler", "error", err)
+		return CollectionResult{}, err
 	}
 
-	logger.Info("Fetched games", "count", len(games))
+	var result CollectionResult
 
-	// Schedule game workflows for upcoming games
-	for _, game := range games {
-		// Only schedule games that haven't started yet
-		if game.Status == "pre" && game.StartTime.After(workflow.Now(ctx)) {
-			err := workflow.ExecuteActivity(ctx, StartGameWorkflowActivity, game).Get(ctx, nil)
+	// A digest collection's games report to its DigestWorkflow instead of sending anything themselves
+	digestWorkflowID := DigestWorkflowID(workflow.GetInfo(ctx).WorkflowExecution.ID)
+	signalDigest := func(signalName string, arg interface{}) {
+		err := workflow.SignalExternalWorkflow(ctx, digestWorkflowID, "", signalName, arg).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to signal digest workflow", "digestWorkflowID", digestWorkflowID, "signal", signalName, "error", err)
+		}
+	}
+	if trackingRequest.Digest && !trackingRequest.DigestStarted {
+		// Abandoned rather than tied to this run, so it outlives the collection (and any Continue-As-New) while the games finish
+		childCtx := workflow.WithChildOptions(ctx, workflow.ChildWorkflowOptions{
+			WorkflowID:        digestWorkflowID,
+			ParentClosePolicy: enumspb.PARENT_CLOSE_POLICY_ABANDON,
+		})
+		err := workflow.ExecuteChildWorkflow(childCtx, DigestWorkflow, trackingRequest).GetChildWorkflowExecution().Get(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to start digest workflow", "digestWorkflowID", digestWorkflowID, "error", err)
+			return result, err
+		}
+		trackingRequest.DigestStarted = true
+		logger.Info("Started digest workflow", "digestWorkflowID", digestWorkflowID)
+	}
+
+	// Games pushed in with the addGame signal - skipped if we've already scheduled them
+	addGameCh := workflow.GetSignalChannel(ctx, AddGameSignal)
+	scheduleAddedGame := func(game Game) {
+		workflowID := GameWorkflowID(game.ID)
+		if slices.Contains(scheduledGames, workflowID) {
+			logger.Info("Added game is already scheduled", "gameID", game.ID)
+			return
+		}
+		if trackingRequest.Digest {
+			game.DigestWorkflowID = digestWorkflowID
+		}
+		err := workflow.ExecuteActivity(startGameCtx, StartGameWorkflowActivity, game).Get(ctx, nil)
+		if err != nil {
+			// Someone asked for one extra game - not worth failing the whole collection over
+			logger.Error("Failed to start game workflow for added game", "gameID", game.ID, "error", err)
+			return
+		}
+		result.ScheduledGames++
+		scheduledGames = append(scheduledGames, workflowID)
+		logger.Info("Scheduled added game", "gameID", game.ID)
+		if trackingRequest.Digest {
+			signalDigest(DigestExpectGameSignal, game.ID)
+		}
+	}
+
+	// Conferences can be given by name ("Big Ten") - look up their ESPN group IDs once, up front
+	if slices.ContainsFunc(trackingRequest.Conferences, conferenceNeedsResolving) {
+		err := workflow.ExecuteActivity(getGamesCtx, ResolveConferencesActivity, trackingRequest).Get(ctx, &trackingRequest.Conferences)
+		if err != nil {
+			logger.Error("Failed to resolve conferences", "conferences", trackingRequest.Conferences, "error", err)
+			return result, err
+		}
+		logger.Info("Resolved conferences", "conferences", trackingRequest.Conferences)
+	}
+
+	for {
+		// Fetch games from ESPN API
+		var games []Game
+		err := workflow.ExecuteActivity(getGamesCtx, GetGamesActivity, trackingRequest).Get(ctx, &games)
+		if err != nil {
+			logger.Error("Failed to fetch games", "error", err)
+			return result, err
+		}
+		result.Polls++
+		result.TotalGames += len(games)
+
+		logger.Info("Fetched games", "count", len(games))
+
+		// Schedule game workflows for upcoming games, earliest first, until the collection has scheduled maxGames
+		var newlyTracked []Game
+		skipped := 0
+		for _, game := range upcomingGames(games, workflow.Now(ctx)) {
+			workflowID := GameWorkflowID(game.ID)
+			alreadyScheduled := slices.Contains(scheduledGames, workflowID)
+			if !alreadyScheduled && len(scheduledGames) >= maxGames {
+				skipped++
+				continue
+			}
+			if trackingRequest.Digest {
+				game.DigestWorkflowID = digestWorkflowID
+			}
+			err := workflow.ExecuteActivity(startGameCtx, StartGameWorkflowActivity, game).Get(ctx, nil)
 			if err != nil {
 				logger.Error("Failed to start game workflow", "gameID", game.ID, "error", err)
-				return 0, err
+				return result, err
+			}
+			if !alreadyScheduled {
+				result.ScheduledGames++
+				scheduledGames = append(scheduledGames, workflowID)
+				newlyTracked = append(newlyTracked, game)
 			}
 		}
+		if skipped > 0 {
+			logger.Warn("Too many games to track, only scheduling the earliest ones", "skipped", skipped, "maxGames", maxGames)
+		}
+
+		// Let people know what they'll be hearing about - only games we haven't announced in an earlier poll. A digest
+		// collection keeps quiet, and tells its digest to wait for them instead.
+		if trackingRequest.Digest {
+			for _, game := range newlyTracked {
+				signalDigest(DigestExpectGameSignal, game.ID)
+			}
+		} else if len(newlyTracked) > 0 {
+			sendNotificationList(ctx, notifyCtx, "", recordedNotificationChannels(ctx), []Notification{buildTrackingSummaryNotification(newlyTracked)})
+		}
+
+		if trackingRequest.PollInterval <= 0 {
+			result.StopReason = CollectionStopSinglePass
+			break
+		}
+
+		if len(games) == 0 {
+			trackingRequest.EmptyPolls++
+		} else {
+			trackingRequest.EmptyPolls = 0
+		}
+		if trackingRequest.EmptyPolls >= maxEmptyPolls {
+			logger.Info("No games found, stopping collection", "emptyPolls", trackingRequest.EmptyPolls)
+			result.StopReason = CollectionStopNoGames
+			break
+		}
+
+		// Wait for the next poll, scheduling any games signalled in while we wait
+		timer := workflow.NewTimer(ctx, time.Duration(trackingRequest.PollInterval))
+		var timerErr error
+		for waiting := true; waiting; {
+			selector := workflow.NewSelector(ctx)
+			selector.AddFuture(timer, func(f workflow.Future) {
+				timerErr = f.Get(ctx, nil)
+				waiting = false
+			})
+			selector.AddReceive(addGameCh, func(c workflow.ReceiveChannel, more bool) {
+				var game Game
+				c.Receive(ctx, &game)
+				scheduleAddedGame(game)
+			})
+			selector.Select(ctx)
+		}
+		if timerErr != nil {
+			return result, timerErr
+		}
+
+		if result.Polls >= maxPollsPerRun {
+			// Don't lose games signalled in right before we hand over to the next run
+			var game Game
+			for addGameCh.ReceiveAsync(&game) {
+				scheduleAddedGame(game)
+			}
+			trackingRequest.ScheduledGames = scheduledGames
+			logger.Info("Continuing as new", "polls", result.Polls, "emptyPolls", trackingRequest.EmptyPolls, "scheduledGames", len(scheduledGames))
+			return result, workflow.NewContinueAsNewError(ctx, CollectGamesWorkflow, trackingRequest)
+		}
 	}
 
-	var totalGames = len(games)
-	logger.Info("Collect Games Workflow completed.")
-	return totalGames, nil
-}
\ No newline at end of file
+	if trackingRequest.Digest {
+		signalDigest(DigestCollectionDoneSignal, nil)
+	}
+
+	logger.Info("Collect Games Workflow completed.", "stopReason", result.StopReason)
+	return result, nil
+}
+
+// recordedNotificationChannels reads the configured channels in a SideEffect, so the choice is kept in history and a
+// worker restarted with different channels still replays this run the same way
+func recordedNotificationChannels(ctx workflow.Context) []string {
+	var channels []string
+	encoded := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return CurrentConfig().NotificationChannels
+	})
+	if err := encoded.Get(&channels); err != nil {
+		workflow.GetLogger(ctx).Error("Failed to read notification channels", "error", err)
+	}
+	return channels
+}
+
+// buildTrackingSummaryNotification lists the games a collection just started tracking, e.g.
+// Now tracking 2 games
+// Michigan Wolverines vs Ohio State Buckeyes
+// Detroit Lions vs Kansas City Chiefs
+func buildTrackingSummaryNotification(games []Game) Notification {
+	noun := "games"
+	if len(games) == 1 {
+		noun = "game"
+	}
+	matchups := make([]string, 0, len(games))
+	for _, game := range games {
+		matchups = append(matchups, fmt.Sprintf("%s vs %s", teamWithRecord(game.HomeTeam), teamWithRecord(game.AwayTeam)))
+	}
+	return Notification{
+		Title:    fmt.Sprintf("Now tracking %d %s", len(games), noun),
+		Message:  strings.Join(matchups, "\n"),
+		Priority: PriorityLow,
+	}
+}
+
+// teamWithRecord returns e.g. "Michigan Wolverines (5-1)", or just the name if there's no record
+func teamWithRecord(team Team) string {
+	if team.Record == "" {
+		return team.DisplayName
+	}
+	return fmt.Sprintf("%s (%s)", team.DisplayName, team.Record)
+}
+
+// upcomingGames returns the games that haven't started yet, earliest first
+func upcomingGames(games []Game, now time.Time) []Game {
+	var upcoming []Game
+	for _, game := range games {
+		if game.IsPregame() && game.StartTime.After(now) {
+			upcoming = append(upcoming, game)
+		}
+	}
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].StartTime.Before(upcoming[j].StartTime)
+	})
+	return upcoming
+}
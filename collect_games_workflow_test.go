@@ -1,12 +1,24 @@
 package sports
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"slices"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/worker"
+	"go.temporal.io/sdk/workflow"
 )
 
 func TestCollectGamesWorkflow(t *testing.T) {
@@ -90,6 +102,352 @@ func TestCollectGamesWorkflow_NoGames(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func TestCollectGamesWorkflow_MaxEmptyPolls(t *testing.T) {
+	testCases := []struct {
+		name          string
+		maxEmptyPolls int
+		expectedPolls int
+	}{
+		{name: "configured limit", maxEmptyPolls: 2, expectedPolls: 2},
+		{name: "default limit", maxEmptyPolls: 0, expectedPolls: defaultMaxEmptyPolls},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+
+			polls := 0
+			env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, req TrackingRequest) ([]Game, error) {
+				polls++
+				return []Game{}, nil
+			})
+
+			trackingRequest := TrackingRequest{
+				Sport:         "football",
+				League:        "college-football",
+				PollInterval:  Duration(time.Hour),
+				MaxEmptyPolls: tc.maxEmptyPolls,
+			}
+
+			env.ExecuteWorkflow(CollectGamesWorkflow, trackingRequest)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+
+			var result CollectionResult
+			require.NoError(t, env.GetWorkflowResult(&result))
+			assert.Equal(t, CollectionStopNoGames, result.StopReason)
+			assert.Equal(t, tc.expectedPolls, result.Polls)
+			assert.Equal(t, tc.expectedPolls, polls)
+		})
+	}
+}
+
+func TestCollectGamesWorkflow_EmptyPollsResetWhenGamesFound(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	// empty, found (already started so nothing gets scheduled), empty, empty -> stops on the 4th poll
+	found := []bool{false, true, false, false}
+	polls := 0
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, req TrackingRequest) ([]Game, error) {
+		polls++
+		if found[polls-1] {
+			return []Game{{ID: "401520281", Status: "in"}}, nil
+		}
+		return []Game{}, nil
+	})
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{
+		Sport:         "football",
+		League:        "college-football",
+		PollInterval:  Duration(time.Hour),
+		MaxEmptyPolls: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result CollectionResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, CollectionStopNoGames, result.StopReason)
+	assert.Equal(t, 4, result.Polls)
+	assert.Equal(t, 1, result.TotalGames)
+}
+
+func TestCollectGamesWorkflow_MaxGames(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// Five upcoming games, listed latest first, plus one that's already underway
+	var games []Game
+	for i := 5; i >= 1; i-- {
+		games = append(games, Game{
+			ID:        fmt.Sprintf("upcoming-%d", i),
+			Status:    "pre",
+			StartTime: workflowStart.Add(time.Duration(i) * time.Hour),
+		})
+	}
+	games = append(games, Game{ID: "in-progress", Status: "in", StartTime: workflowStart.Add(-time.Hour)})
+
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(games, nil)
+
+	var scheduled []string
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) error {
+		scheduled = append(scheduled, game.ID)
+		return nil
+	})
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{
+		Sport:    "football",
+		League:   "college-football",
+		MaxGames: 3,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result CollectionResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, 6, result.TotalGames)
+	assert.Equal(t, 3, result.ScheduledGames)
+
+	// Only the three earliest games get scheduled
+	assert.Equal(t, []string{"upcoming-1", "upcoming-2", "upcoming-3"}, scheduled)
+}
+
+func TestCollectGamesWorkflow_MaxGamesAcrossPolls(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	games := []Game{
+		{ID: "401520281", Status: "pre", StartTime: workflowStart.Add(2 * time.Hour)},
+		{ID: "401520282", Status: "pre", StartTime: workflowStart.Add(3 * time.Hour)},
+	}
+	// The same two games twice, then a third turns up - the cap is for the whole collection, not each poll
+	polls := 0
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, req TrackingRequest) ([]Game, error) {
+		polls++
+		switch polls {
+		case 1, 2:
+			return games, nil
+		case 3:
+			return append(games, Game{ID: "401520283", Status: "pre", StartTime: workflowStart.Add(4 * time.Hour)}), nil
+		}
+		return []Game{}, nil
+	})
+
+	var started []string
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) error {
+		started = append(started, game.ID)
+		return nil
+	})
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{
+		Sport:         "football",
+		League:        "college-football",
+		PollInterval:  Duration(time.Minute),
+		MaxEmptyPolls: 1,
+		MaxGames:      2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result CollectionResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, 4, result.Polls)
+	assert.Equal(t, 7, result.TotalGames)
+	// Found again on later polls, the first two aren't counted twice, and the third is over the cap
+	assert.Equal(t, 2, result.ScheduledGames)
+	assert.NotContains(t, started, "401520283")
+
+	encoded, err := env.QueryWorkflow("scheduledGames")
+	require.NoError(t, err)
+	var scheduledGames []string
+	require.NoError(t, encoded.Get(&scheduledGames))
+	assert.Equal(t, []string{"game-401520281", "game-401520282"}, scheduledGames)
+}
+
+func TestCollectGamesWorkflow_TrackingSummary(t *testing.T) {
+	t.Setenv("NOTIFICATION_CHANNELS", "logger,slack")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	games := []Game{
+		{
+			ID:        "401520281",
+			Status:    "pre",
+			StartTime: workflowStart.Add(5 * time.Hour),
+			HomeTeam:  Team{DisplayName: "Michigan Wolverines"},
+			AwayTeam:  Team{DisplayName: "Ohio State Buckeyes"},
+		},
+		{
+			ID:        "401520282",
+			Status:    "pre",
+			StartTime: workflowStart.Add(4 * time.Hour),
+			HomeTeam:  Team{DisplayName: "Georgia Bulldogs"},
+			AwayTeam:  Team{DisplayName: "Georgia Tech Yellow Jackets"},
+		},
+	}
+
+	// The same games twice (the second poll mustn't announce them again), then nothing
+	polls := 0
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, req TrackingRequest) ([]Game, error) {
+		polls++
+		if polls <= 2 {
+			return games, nil
+		}
+		return []Game{}, nil
+	})
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(nil)
+
+	var sent []SendNotifications
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications)
+		return nil
+	})
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{
+		Sport:         "football",
+		League:        "college-football",
+		PollInterval:  Duration(time.Hour),
+		MaxEmptyPolls: 1,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, 3, polls)
+
+	// One summary, sent to each configured channel, listing the games earliest first
+	require.Len(t, sent, 2)
+	assert.Equal(t, []string{"logger", "slack"}, []string{sent[0].Channel, sent[1].Channel})
+	for _, sendNotifications := range sent {
+		require.Len(t, sendNotifications.NotificationList, 1)
+		notification := sendNotifications.NotificationList[0]
+		assert.Equal(t, "Now tracking 2 games", notification.Title)
+		assert.Equal(t, "Georgia Bulldogs vs Georgia Tech Yellow Jackets\nMichigan Wolverines vs Ohio State Buckeyes", notification.Message)
+		assert.Equal(t, PriorityLow, notification.Priority)
+	}
+}
+
+func TestCollectGamesWorkflow_NoRepeatTrackingSummaryAfterContinueAsNew(t *testing.T) {
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	games := []Game{
+		{ID: "401520281", Status: "pre", StartTime: workflowStart.Add(300 * time.Hour)},
+		{ID: "401520282", Status: "pre", StartTime: workflowStart.Add(301 * time.Hour)},
+	}
+	trackingRequest := TrackingRequest{
+		Sport:         "football",
+		League:        "college-football",
+		PollInterval:  Duration(time.Hour),
+		MaxEmptyPolls: 1,
+	}
+
+	var summaries []string
+	runCollection := func(start time.Time, polls func(int) []Game, request TrackingRequest) *testsuite.TestWorkflowEnvironment {
+		testSuite := &testsuite.WorkflowTestSuite{}
+		env := testSuite.NewTestWorkflowEnvironment()
+		env.SetStartTime(start)
+		poll := 0
+		env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, req TrackingRequest) ([]Game, error) {
+			poll++
+			return polls(poll), nil
+		})
+		env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(nil)
+		env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+			for _, notification := range sendNotifications.NotificationList {
+				summaries = append(summaries, notification.Title)
+			}
+			return nil
+		})
+		env.ExecuteWorkflow(CollectGamesWorkflow, request)
+		require.True(t, env.IsWorkflowCompleted())
+		return env
+	}
+
+	// The first run finds the same games on every poll until it hands over to the next one
+	env := runCollection(workflowStart, func(int) []Game { return games }, trackingRequest)
+	var continueAsNew *workflow.ContinueAsNewError
+	require.ErrorAs(t, env.GetWorkflowError(), &continueAsNew)
+	var nextRequest TrackingRequest
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayloads(continueAsNew.Input, &nextRequest))
+	assert.Equal(t, []string{"game-401520281", "game-401520282"}, nextRequest.ScheduledGames)
+
+	// The next run finds them again, then nothing
+	env = runCollection(workflowStart.Add(maxPollsPerRun*time.Hour), func(poll int) []Game {
+		if poll == 1 {
+			return games
+		}
+		return []Game{}
+	}, nextRequest)
+	require.NoError(t, env.GetWorkflowError())
+
+	var result CollectionResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, 0, result.ScheduledGames)
+	// Only the first run announced them
+	assert.Equal(t, []string{"Now tracking 2 games"}, summaries)
+
+	encoded, err := env.QueryWorkflow("scheduledGames")
+	require.NoError(t, err)
+	var scheduledGames []string
+	require.NoError(t, encoded.Get(&scheduledGames))
+	assert.Equal(t, []string{"game-401520281", "game-401520282"}, scheduledGames)
+}
+
+func TestCollectGamesWorkflow_NoTrackingSummaryWithoutGames(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	// Only a game that's already underway, so nothing gets scheduled
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return([]Game{{ID: "401520281", Status: "in"}}, nil)
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{Sport: "football", League: "nfl"})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertNotCalled(t, "SendNotificationListActivity", mock.Anything, mock.Anything)
+}
+
+func TestBuildTrackingSummaryNotification(t *testing.T) {
+	notification := buildTrackingSummaryNotification([]Game{{
+		HomeTeam: Team{DisplayName: "Detroit Lions"},
+		AwayTeam: Team{DisplayName: "Kansas City Chiefs"},
+	}})
+	assert.Equal(t, "Now tracking 1 game", notification.Title)
+	assert.Equal(t, "Detroit Lions vs Kansas City Chiefs", notification.Message)
+
+	// With records
+	notification = buildTrackingSummaryNotification([]Game{{
+		HomeTeam: Team{DisplayName: "Michigan", Record: "5-1"},
+		AwayTeam: Team{DisplayName: "Washington", Record: "4-2"},
+	}})
+	assert.Equal(t, "Michigan (5-1) vs Washington (4-2)", notification.Message)
+}
+
+func TestCollectGamesWorkflow_ReplayAfterWorkerRestart(t *testing.T) {
+	// Recorded history of a collection whose worker went away while it was starting the second of two games:
+	// the games were fetched and the first one started, and the second start was in flight. Replaying it has to
+	// produce exactly those commands - in particular, not start the first game again.
+	replayer := worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflow(CollectGamesWorkflow)
+
+	err := replayer.ReplayWorkflowHistoryFromJSONFile(nil, "testdata/collect_games_partial_history.json")
+	require.NoError(t, err)
+}
+
 func TestCollectGamesWorkflow_GetGamesFailure(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -112,6 +470,49 @@ func TestCollectGamesWorkflow_GetGamesFailure(t *testing.T) {
 	assert.Error(t, env.GetWorkflowError())
 }
 
+func TestCollectGamesWorkflow_ConferenceNames(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.OnActivity(ResolveConferencesActivity, mock.Anything, mock.Anything).Return([]string{"5", "18"}, nil).Once()
+
+	// The games are fetched with the resolved group IDs
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.MatchedBy(func(req TrackingRequest) bool {
+		return slices.Equal(req.Conferences, []string{"5", "18"})
+	})).Return([]Game{}, nil).Once()
+
+	trackingRequest := TrackingRequest{
+		Sport:       "football",
+		League:      "college-football",
+		Conferences: []string{"Big Ten", "18"},
+	}
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, trackingRequest)
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+func TestCollectGamesWorkflow_UnknownConferenceName(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.OnActivity(ResolveConferencesActivity, mock.Anything, mock.Anything).Return(nil,
+		temporal.NewNonRetryableApplicationError("unknown conference \"Pac-12\"", "InvalidTrackingRequest", nil))
+
+	trackingRequest := TrackingRequest{
+		Sport:       "football",
+		League:      "college-football",
+		Conferences: []string{"Pac-12"},
+	}
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, trackingRequest)
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.ErrorContains(t, env.GetWorkflowError(), "unknown conference")
+}
+
 func TestCollectGamesWorkflow_StartGameWorkflowFailure(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -158,7 +559,7 @@ func TestCollectGamesWorkflow_FiltersPastGames(t *testing.T) {
 		{
 			ID:        "game-past",
 			StartTime: time.Now().Add(-time.Hour), // Past game
-			Status:    "final",
+			Status:    "post", // ESPN's state for a finished game
 			HomeTeam: Team{
 				ID:          "130",
 				DisplayName: "Michigan Wolverines",
@@ -196,11 +597,12 @@ func TestCollectGamesWorkflow_FiltersPastGames(t *testing.T) {
 		},
 	}
 
-	env.OnActivity(GetGamesActivity, mock.Anything).Return(testGames, nil)
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(testGames, nil)
 	// Only the future game should trigger StartGameWorkflowActivity
-	env.OnActivity(StartGameWorkflowActivity, mock.MatchedBy(func(game Game) bool {
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.MatchedBy(func(game Game) bool {
 		return game.ID == "game-future"
 	})).Return(nil).Once()
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil)
 
 	trackingRequest := TrackingRequest{
 		Sport:       "football",
@@ -219,6 +621,38 @@ func TestCollectGamesWorkflow_FiltersPastGames(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
+func TestUpcomingGames_SkipsFinishedESPNGames(t *testing.T) {
+	now := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+
+	// Shaped like ESPN's scoreboard: a finished game is state "post" with completed set, never "final".
+	// The second one is a game that ESPN has marked completed but still has a start time in the future (a forfeit).
+	var espnResp ESPNResponse
+	require.NoError(t, json.Unmarshal([]byte(`{"events": [
+		{"id": "401520280", "competitions": [{"id": "401520280", "date": "2024-11-30T08:00Z",
+			"competitors": [{"team": {"id": "130"}, "homeAway": "home", "score": "30"}, {"team": {"id": "194"}, "homeAway": "away", "score": "24"}],
+			"status": {"type": {"name": "STATUS_FINAL", "state": "post", "completed": true, "detail": "Final"}}}]},
+		{"id": "401520281", "competitions": [{"id": "401520281", "date": "2024-11-30T20:00Z",
+			"competitors": [{"team": {"id": "61"}, "homeAway": "home"}, {"team": {"id": "59"}, "homeAway": "away"}],
+			"status": {"type": {"name": "STATUS_FORFEIT", "state": "", "completed": true}}}]},
+		{"id": "401520282", "competitions": [{"id": "401520282", "date": "2024-11-30T17:00Z",
+			"competitors": [{"team": {"id": "213"}, "homeAway": "home"}, {"team": {"id": "356"}, "homeAway": "away"}],
+			"status": {"type": {"name": "STATUS_SCHEDULED", "state": "pre", "completed": false}}}]}
+	]}`), &espnResp))
+
+	var games []Game
+	for _, event := range espnResp.Events {
+		comp := event.Competitions[0]
+		games = append(games, BuildGame(event.ID, comp, comp.Competitors[0], comp.Competitors[1], "", TrackingRequest{}))
+	}
+	require.Len(t, games, 3)
+	assert.True(t, games[0].IsFinal())
+	assert.True(t, games[1].IsFinal())
+
+	upcoming := upcomingGames(games, now)
+	require.Len(t, upcoming, 1)
+	assert.Equal(t, "401520282", upcoming[0].ID)
+}
+
 func TestCollectGamesWorkflow_MultipleTeams(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -389,3 +823,117 @@ func BenchmarkCollectGamesWorkflow(b *testing.B) {
 		env.ExecuteWorkflow(CollectGamesWorkflow, trackingRequest)
 	}
 }
+
+func TestCollectGamesWorkflow_ScheduledGamesQuery(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	games := []Game{
+		{ID: "401520281", Status: "pre", StartTime: workflowStart.Add(time.Hour)},
+		{ID: "401520282", Status: "pre", StartTime: workflowStart.Add(2 * time.Hour)},
+		{ID: "401520283", Status: "in", StartTime: workflowStart.Add(-time.Hour)},
+	}
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(games, nil)
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(nil)
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{Sport: "football", League: "college-football"})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	encoded, err := env.QueryWorkflow("scheduledGames")
+	require.NoError(t, err)
+
+	var scheduledGames []string
+	require.NoError(t, encoded.Get(&scheduledGames))
+	assert.Equal(t, []string{"game-401520281", "game-401520282"}, scheduledGames)
+}
+
+func TestCollectGamesWorkflow_AddGameSignal(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// ESPN never finds anything, so the only game is the one signalled in
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return([]Game{}, nil)
+
+	var scheduled []string
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) error {
+		scheduled = append(scheduled, game.ID)
+		return nil
+	})
+
+	added := Game{ID: "401520281", Status: "pre", StartTime: workflowStart.Add(3 * time.Hour)}
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(AddGameSignal, added)
+	}, 10*time.Minute)
+	// The same game again is ignored
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(AddGameSignal, added)
+	}, 20*time.Minute)
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{
+		Sport:         "football",
+		League:        "college-football",
+		PollInterval:  Duration(time.Hour),
+		MaxEmptyPolls: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var result CollectionResult
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, 1, result.ScheduledGames)
+	assert.Equal(t, CollectionStopNoGames, result.StopReason)
+	assert.Equal(t, []string{"401520281"}, scheduled)
+
+	encoded, err := env.QueryWorkflow("scheduledGames")
+	require.NoError(t, err)
+	var scheduledGames []string
+	require.NoError(t, encoded.Get(&scheduledGames))
+	assert.Equal(t, []string{"game-401520281"}, scheduledGames)
+}
+
+func TestCollectGamesWorkflow_Digest(t *testing.T) {
+	// The digest is never told about the games finishing, so it gives up waiting on them - and logs what it expected
+	logger := &recordingLogger{Logger: log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), message: "Gave up waiting for games, sending the digest without them"}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(logger)
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+	env.RegisterWorkflow(DigestWorkflow)
+
+	games := []Game{
+		{ID: "401520281", Status: "pre", StartTime: workflowStart.Add(5 * time.Hour)},
+		{ID: "401520282", Status: "pre", StartTime: workflowStart.Add(4 * time.Hour)},
+	}
+	env.OnActivity(GetGamesActivity, mock.Anything, mock.Anything).Return(games, nil)
+
+	var scheduled []Game
+	env.OnActivity(StartGameWorkflowActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) error {
+		scheduled = append(scheduled, game)
+		return nil
+	})
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	env.ExecuteWorkflow(CollectGamesWorkflow, TrackingRequest{Sport: "football", League: "college-football", Digest: true})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// No "Now tracking" message - the games are handed to the digest instead
+	env.AssertNotCalled(t, "SendNotificationListActivity", mock.Anything, mock.Anything)
+	require.Len(t, scheduled, 2)
+	for _, game := range scheduled {
+		assert.Equal(t, DigestWorkflowID("default-test-workflow-id"), game.DigestWorkflowID)
+	}
+
+	require.Len(t, logger.lines, 1)
+	assert.Equal(t, []string{"401520282", "401520281"}, keyval(logger.lines[0], "missingGameIDs"))
+	assert.Equal(t, true, keyval(logger.lines[0], "collectionDone"))
+}
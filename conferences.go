@@ -0,0 +1,167 @@
+package sports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/temporal"
+)
+
+// conferencesCacheTTL is how long a league's conferences are reused before asking ESPN again - they only change
+// between seasons, so once a day is plenty
+const conferencesCacheTTL = 24 * time.Hour
+
+// errUnknownConference is returned by ResolveConference for a name ESPN doesn't have
+var errUnknownConference = errors.New("unknown conference")
+
+type conferencesCacheEntry struct {
+	conferences []Group
+	fetchedAt   time.Time
+}
+
+// conferencesCache is keyed by the groups URL, so clients pointed at different servers (e.g. in tests) don't share entries
+var conferencesCache = struct {
+	sync.Mutex
+	entries map[string]conferencesCacheEntry
+}{entries: make(map[string]conferencesCacheEntry)}
+
+// Conferences returns the conferences ESPN has for a sport/league, e.g. "Big Ten" rather than "FBS", with any
+// divisions they're split into (SEC East and West) as their Children. Results are cached for conferencesCacheTTL, and an expired list is still used if ESPN
+// can't be reached to replace it.
+func (c *ESPNClient) Conferences(ctx context.Context, sport string, league string) ([]Group, error) {
+	url := c.APIRoot(sport, league) + "/groups"
+
+	conferencesCache.Lock()
+	entry, ok := conferencesCache.entries[url]
+	conferencesCache.Unlock()
+	if ok && time.Since(entry.fetchedAt) < conferencesCacheTTL {
+		return entry.conferences, nil
+	}
+
+	conferences, err := c.RefreshConferences(ctx, sport, league)
+	if err != nil && ok {
+		return entry.conferences, nil
+	}
+	return conferences, err
+}
+
+// RefreshConferences fetches a sport/league's conferences from ESPN and caches them, whether or not the cached
+// list has expired. The web server uses it to load every college league at startup and again each day.
+func (c *ESPNClient) RefreshConferences(ctx context.Context, sport string, league string) ([]Group, error) {
+	url := c.APIRoot(sport, league) + "/groups"
+
+	var groupsResp GroupsResponse
+	if err := c.GetJSON(ctx, url, &groupsResp); err != nil {
+		return nil, err
+	}
+	conferences := conferenceGroups(groupsResp.Groups)
+
+	conferencesCache.Lock()
+	conferencesCache.entries[url] = conferencesCacheEntry{conferences: conferences, fetchedAt: time.Now()}
+	conferencesCache.Unlock()
+	return conferences, nil
+}
+
+// conferenceGroups picks the conferences out of ESPN's group tree. The top level is the NCAA divisions (FBS, FCS)
+// and the conferences are under those - anything further down is a conference's own divisions, which are kept as
+// its Children. A top-level group with nothing under it is taken to be a conference itself.
+func conferenceGroups(groups []Group) []Group {
+	var conferences []Group
+	for _, group := range groups {
+		if len(group.Children) == 0 {
+			conferences = append(conferences, group)
+			continue
+		}
+		for _, conference := range group.Children {
+			conference.Children = leafGroups(conference.Children)
+			conferences = append(conferences, conference)
+		}
+	}
+	return conferences
+}
+
+// leafGroups flattens ESPN's group tree down to the groups at the bottom of it
+func leafGroups(groups []Group) []Group {
+	var leaves []Group
+	for _, group := range groups {
+		if len(group.Children) == 0 {
+			leaves = append(leaves, group)
+			continue
+		}
+		leaves = append(leaves, leafGroups(group.Children)...)
+	}
+	return leaves
+}
+
+// ResolveConference turns a conference name into its ESPN group ID, matching (case-insensitively) the full name,
+// short name or abbreviation - "Big Ten", "Big Ten Conference" and "big10" are all 5 in college football.
+// Conference divisions ("SEC East") resolve too, but a conference wins if a name matches both.
+// Anything numeric is taken to be a group ID already.
+func (c *ESPNClient) ResolveConference(ctx context.Context, sport string, league string, name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if _, err := strconv.ParseUint(name, 10, 64); err == nil {
+		return name, nil
+	}
+
+	conferences, err := c.Conferences(ctx, sport, league)
+	if err != nil {
+		return "", err
+	}
+	for _, conf := range conferences {
+		if groupNamed(conf, name) {
+			return conf.GroupID, nil
+		}
+	}
+	for _, conf := range conferences {
+		for _, division := range conf.Children {
+			if groupNamed(division, name) {
+				return division.GroupID, nil
+			}
+		}
+	}
+	return "", fmt.Errorf("%w %q for %s/%s", errUnknownConference, name, sport, league)
+}
+
+func groupNamed(group Group, name string) bool {
+	return strings.EqualFold(name, group.Name) || strings.EqualFold(name, group.ShortName) || strings.EqualFold(name, group.Abbreviation)
+}
+
+// ResolveConference resolves a conference name with DefaultESPNClient
+func ResolveConference(ctx context.Context, sport string, league string, name string) (string, error) {
+	return DefaultESPNClient.ResolveConference(ctx, sport, league, name)
+}
+
+// conferenceNeedsResolving reports whether a requested conference is a name rather than an ESPN group ID
+func conferenceNeedsResolving(conference string) bool {
+	_, err := strconv.ParseUint(strings.TrimSpace(conference), 10, 64)
+	return err != nil
+}
+
+// ResolveConferencesActivity maps the request's conferences to ESPN group IDs, so they can be given by name
+func ResolveConferencesActivity(ctx context.Context, trackingRequest TrackingRequest) ([]string, error) {
+	logger := activity.GetLogger(ctx)
+
+	var groupIDs []string
+	for _, conf := range trackingRequest.Conferences {
+		if !conferenceNeedsResolving(conf) {
+			groupIDs = append(groupIDs, strings.TrimSpace(conf))
+			continue
+		}
+		groupID, err := ResolveConference(ctx, trackingRequest.Sport, trackingRequest.League, conf)
+		if errors.Is(err, errUnknownConference) {
+			return nil, temporal.NewNonRetryableApplicationError(err.Error(), "InvalidTrackingRequest", nil)
+		}
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("Resolved conference", "name", conf, "groupID", groupID)
+		groupIDs = append(groupIDs, groupID)
+	}
+	return groupIDs, nil
+}
@@ -0,0 +1,195 @@
+package sports
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+// groupsPayload is a trimmed-down copy of ESPN's college-football groups response
+const groupsPayload = `{
+	"groups": [
+		{
+			"groupId": "80",
+			"name": "NCAA Division I-A",
+			"abbreviation": "FBS",
+			"children": [
+				{"groupId": "1", "name": "Atlantic Coast Conference", "shortName": "ACC", "abbreviation": "acc"},
+				{"groupId": "5", "name": "Big Ten Conference", "shortName": "Big Ten", "abbreviation": "big10"},
+				{"groupId": "8", "name": "Southeastern Conference", "shortName": "SEC", "abbreviation": "sec"},
+				{"groupId": "18", "name": "FBS Independents", "shortName": "FBS Indep.", "abbreviation": "ind"}
+			]
+		},
+		{
+			"groupId": "81",
+			"name": "NCAA Division I-AA",
+			"abbreviation": "FCS",
+			"children": [
+				{"groupId": "48", "name": "Ivy League", "shortName": "Ivy", "abbreviation": "ivy"}
+			]
+		}
+	]
+}`
+
+func newGroupsServer(t *testing.T, requests *int) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		assert.Equal(t, "/football/college-football/groups", r.URL.Path)
+		w.Write([]byte(groupsPayload))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestResolveConference(t *testing.T) {
+	requests := 0
+	client := NewESPNClient(newGroupsServer(t, &requests).URL)
+
+	tests := []struct {
+		name          string
+		conference    string
+		expectedID    string
+		expectedError bool
+	}{
+		{name: "short name", conference: "Big Ten", expectedID: "5"},
+		{name: "full name", conference: "Big Ten Conference", expectedID: "5"},
+		{name: "abbreviation, any case", conference: "BIG10", expectedID: "5"},
+		{name: "surrounding spaces", conference: " SEC ", expectedID: "8"},
+		{name: "conference in another division", conference: "Ivy", expectedID: "48"},
+		{name: "group ID is passed through", conference: "18", expectedID: "18"},
+		{name: "division isn't a conference", conference: "FBS", expectedError: true},
+		{name: "unknown", conference: "Pac-12", expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := client.ResolveConference(context.Background(), "football", "college-football", tt.conference)
+			if tt.expectedError {
+				assert.ErrorIs(t, err, errUnknownConference)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedID, id)
+		})
+	}
+
+	// Every lookup after the first came from the cache
+	assert.Equal(t, 1, requests)
+}
+
+func TestESPNClient_ConferencesExpired(t *testing.T) {
+	espnDown := false
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if espnDown {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(groupsPayload))
+	}))
+	defer server.Close()
+	client := NewESPNClient(server.URL)
+	client.Retries = 0
+
+	conferences, err := client.Conferences(context.Background(), "football", "college-football")
+	require.NoError(t, err)
+	require.Len(t, conferences, 5)
+
+	// A day later the list has expired, but ESPN is down - the old list is better than nothing
+	url := client.APIRoot("football", "college-football") + "/groups"
+	conferencesCache.Lock()
+	entry := conferencesCache.entries[url]
+	entry.fetchedAt = entry.fetchedAt.Add(-conferencesCacheTTL)
+	conferencesCache.entries[url] = entry
+	conferencesCache.Unlock()
+	espnDown = true
+
+	conferences, err = client.Conferences(context.Background(), "football", "college-football")
+	require.NoError(t, err)
+	assert.Len(t, conferences, 5)
+	assert.Equal(t, 2, requests, "the expired list should have been refetched")
+}
+
+func TestESPNClient_ConferenceDivisions(t *testing.T) {
+	// The SEC as it was split up until 2024
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"groups": [{"groupId": "80", "name": "NCAA Division I-A", "abbreviation": "FBS", "children": [
+			{"groupId": "5", "name": "Big Ten Conference", "shortName": "Big Ten", "abbreviation": "big10"},
+			{"groupId": "8", "name": "Southeastern Conference", "shortName": "SEC", "abbreviation": "sec", "children": [
+				{"groupId": "12", "name": "SEC - East", "shortName": "SEC East"},
+				{"groupId": "13", "name": "SEC - West", "shortName": "SEC West"}
+			]}
+		]}]}`))
+	}))
+	defer server.Close()
+	client := NewESPNClient(server.URL)
+
+	conferences, err := client.Conferences(context.Background(), "football", "college-football")
+	require.NoError(t, err)
+	require.Len(t, conferences, 2)
+	assert.Equal(t, "5", conferences[0].GroupID)
+	assert.Empty(t, conferences[0].Children)
+	assert.Equal(t, "8", conferences[1].GroupID)
+	require.Len(t, conferences[1].Children, 2)
+	assert.Equal(t, "SEC East", conferences[1].Children[0].ShortName)
+
+	tests := []struct {
+		name       string
+		expectedID string
+	}{
+		{"SEC", "8"},
+		{"SEC West", "13"},
+		{"sec - east", "12"},
+	}
+	for _, tt := range tests {
+		id, err := client.ResolveConference(context.Background(), "football", "college-football", tt.name)
+		require.NoError(t, err)
+		assert.Equal(t, tt.expectedID, id, tt.name)
+	}
+}
+
+func TestResolveConferencesActivity(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(ResolveConferencesActivity)
+
+	requests := 0
+	originalClient := DefaultESPNClient
+	DefaultESPNClient = NewESPNClient(newGroupsServer(t, &requests).URL)
+	defer func() { DefaultESPNClient = originalClient }()
+
+	t.Run("names and IDs", func(t *testing.T) {
+		encodedValue, err := env.ExecuteActivity(ResolveConferencesActivity, TrackingRequest{
+			Sport:       "football",
+			League:      "college-football",
+			Conferences: []string{"Big Ten", "18", "sec"},
+		})
+		require.NoError(t, err)
+
+		var groupIDs []string
+		require.NoError(t, encodedValue.Get(&groupIDs))
+		assert.Equal(t, []string{"5", "18", "8"}, groupIDs)
+	})
+
+	t.Run("unknown conference", func(t *testing.T) {
+		_, err := env.ExecuteActivity(ResolveConferencesActivity, TrackingRequest{
+			Sport:       "football",
+			League:      "college-football",
+			Conferences: []string{"Pac-12"},
+		})
+		require.Error(t, err)
+
+		var appErr *temporal.ApplicationError
+		require.True(t, errors.As(err, &appErr))
+		assert.Equal(t, "InvalidTrackingRequest", appErr.Type())
+		assert.True(t, appErr.NonRetryable())
+	})
+}
@@ -0,0 +1,230 @@
+package sports
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"gopkg.in/yaml.v3"
+)
+
+// defaultConfigFile is read if it exists and CONFIG_FILE isn't set
+const defaultConfigFile = "config.yaml"
+
+// DefaultGameInfoConcurrency is how many gameInfo queries the web server runs at once when listing workflows
+const DefaultGameInfoConcurrency = 8
+
+// DefaultNtfyServer is where ntfy notifications go unless NTFY_SERVER points at a self-hosted one
+const DefaultNtfyServer = "https://ntfy.sh"
+
+// Config is everything the worker and web server read from the environment (and optional config file), loaded once at startup
+type Config struct {
+	TemporalHost      string // TEMPORAL_HOST, e.g. "localhost:7233"
+	TemporalNamespace string // TEMPORAL_NAMESPACE
+	TemporalAPIKey    string // TEMPORAL_API_KEY, required for anything but a local server
+	TaskQueue         string // TASK_QUEUE
+
+	NotificationTypes    []string // NOTIFICATION_TYPES, default score_change
+	NotificationChannels []string // NOTIFICATION_CHANNELS, default logger
+
+	// Defaults for tracking requests that leave these out
+	PollInterval time.Duration // POLL_INTERVAL, e.g. "6h"
+	Conferences  []string      // CONFERENCES, comma-separated conference IDs
+
+	SlackBotToken       string // SLACK_BOT_TOKEN
+	SlackChannelID      string // SLACK_CHANNEL_ID, comma-separated for more than one
+	SlackUseBlocks      bool   // SLACK_USE_BLOCKS=true
+	HassWebhookURL      string // HASS_WEBHOOK_URL
+	PagerDutyRoutingKey string // PAGERDUTY_ROUTING_KEY
+	NtfyServer          string // NTFY_SERVER, default https://ntfy.sh
+	NtfyTopic           string // NTFY_TOPIC
+	ResultsWebhookURL   string // RESULTS_WEBHOOK_URL
+
+	GameInfoConcurrency int // GAME_INFO_CONCURRENCY, default 8
+}
+
+// config is set once at startup by SetConfig. Until then (e.g. in tests) CurrentConfig reads the environment each time.
+var config *Config
+
+// fileConfig is the part of Config that can come from the config file. YAML is a superset of JSON, so a JSON file works too.
+type fileConfig struct {
+	NotificationTypes    []string      `yaml:"notificationTypes"`
+	NotificationChannels []string      `yaml:"notificationChannels"`
+	PollInterval         time.Duration `yaml:"pollInterval"`
+	Conferences          []string      `yaml:"conferences"`
+}
+
+// LoadConfig loads a .env file if there is one, then the config file (CONFIG_FILE, or config.yaml if it exists),
+// then the environment - env vars win over the file. The result is validated.
+func LoadConfig() (Config, error) {
+	setDefaultLogger()
+
+	if err := godotenv.Load(); err != nil {
+		slog.Warn("No .env file found, relying on environment variables")
+	}
+
+	var cfg Config
+	path := os.Getenv("CONFIG_FILE")
+	if path == "" {
+		path = defaultConfigFile
+		if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+			path = ""
+		}
+	}
+	if path != "" {
+		fileCfg, err := loadConfigFile(path)
+		if err != nil {
+			return cfg, err
+		}
+		cfg.NotificationTypes = fileCfg.NotificationTypes
+		cfg.NotificationChannels = fileCfg.NotificationChannels
+		cfg.PollInterval = fileCfg.PollInterval
+		cfg.Conferences = fileCfg.Conferences
+	}
+
+	cfg, err := mergeEnv(cfg)
+	if err != nil {
+		return cfg, err
+	}
+	if err := cfg.Validate(); err != nil {
+		return cfg, err
+	}
+
+	// Unknown channels are skipped when sending, so one typo only matters if it leaves nothing to send to
+	known, unknown := knownChannels(cfg.NotificationChannels)
+	if len(unknown) > 0 {
+		slog.Warn("Unknown notification channels will be skipped", "channels", unknown)
+	}
+	if len(known) == 0 {
+		return cfg, fmt.Errorf("NOTIFICATION_CHANNELS has no known channels (options: %s)", strings.Join(supportedChannels, ","))
+	}
+	return cfg, nil
+}
+
+func loadConfigFile(path string) (fileConfig, error) {
+	var fileCfg fileConfig
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fileCfg, fmt.Errorf("reading config file: %w", err)
+	}
+	if err := yaml.Unmarshal(data, &fileCfg); err != nil {
+		return fileCfg, fmt.Errorf("parsing config file %s: %w", path, err)
+	}
+	return fileCfg, nil
+}
+
+func setDefaultLogger() {
+	logger := slog.New(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{
+		Level: slog.LevelInfo,
+	}))
+	slog.SetDefault(logger)
+}
+
+// SetConfig makes cfg the config used by activities and workflows in this process
+func SetConfig(cfg Config) {
+	config = &cfg
+}
+
+// CurrentConfig returns the config set by SetConfig, or the current environment if nothing has been set
+func CurrentConfig() Config {
+	if config != nil {
+		return *config
+	}
+	return configFromEnv()
+}
+
+func configFromEnv() Config {
+	// LoadConfig has already reported a bad POLL_INTERVAL at startup, so here it's just left at zero
+	cfg, _ := mergeEnv(Config{})
+	return cfg
+}
+
+// mergeEnv overrides cfg with whatever is set in the environment, then fills in the defaults
+func mergeEnv(cfg Config) (Config, error) {
+	cfg.TemporalHost = os.Getenv("TEMPORAL_HOST")
+	cfg.TemporalNamespace = os.Getenv("TEMPORAL_NAMESPACE")
+	cfg.TemporalAPIKey = os.Getenv("TEMPORAL_API_KEY")
+	cfg.TaskQueue = os.Getenv("TASK_QUEUE")
+	cfg.SlackBotToken = os.Getenv("SLACK_BOT_TOKEN")
+	cfg.SlackChannelID = os.Getenv("SLACK_CHANNEL_ID")
+	cfg.SlackUseBlocks = os.Getenv("SLACK_USE_BLOCKS") == "true"
+	cfg.HassWebhookURL = os.Getenv("HASS_WEBHOOK_URL")
+	cfg.PagerDutyRoutingKey = os.Getenv("PAGERDUTY_ROUTING_KEY")
+	cfg.NtfyServer = os.Getenv("NTFY_SERVER")
+	cfg.NtfyTopic = os.Getenv("NTFY_TOPIC")
+	cfg.ResultsWebhookURL = os.Getenv("RESULTS_WEBHOOK_URL")
+
+	// These can also come from the config file
+	if value := os.Getenv("NOTIFICATION_TYPES"); value != "" {
+		cfg.NotificationTypes = strings.Split(value, ",")
+	}
+	if value := os.Getenv("NOTIFICATION_CHANNELS"); value != "" {
+		cfg.NotificationChannels = strings.Split(value, ",")
+	}
+	if value := os.Getenv("CONFERENCES"); value != "" {
+		cfg.Conferences = strings.Split(value, ",")
+	}
+	if value := os.Getenv("POLL_INTERVAL"); value != "" {
+		pollInterval, err := time.ParseDuration(value)
+		if err != nil {
+			return cfg, fmt.Errorf("invalid POLL_INTERVAL %q: %w", value, err)
+		}
+		cfg.PollInterval = pollInterval
+	}
+	if value := os.Getenv("GAME_INFO_CONCURRENCY"); value != "" {
+		concurrency, err := strconv.Atoi(value)
+		if err != nil || concurrency < 1 {
+			return cfg, fmt.Errorf("invalid GAME_INFO_CONCURRENCY %q: must be a positive number", value)
+		}
+		cfg.GameInfoConcurrency = concurrency
+	}
+
+	if len(cfg.NotificationTypes) == 0 {
+		cfg.NotificationTypes = []string{"score_change"} // if not set, default to notifying if the score changes
+	}
+	if len(cfg.NotificationChannels) == 0 {
+		cfg.NotificationChannels = []string{"logger"} // if not set, default to just logging the message
+	}
+	if cfg.GameInfoConcurrency == 0 {
+		cfg.GameInfoConcurrency = DefaultGameInfoConcurrency
+	}
+	if cfg.NtfyServer == "" {
+		cfg.NtfyServer = DefaultNtfyServer
+	}
+	return cfg, nil
+}
+
+// ApplyTrackingDefaults fills in the configured poll interval, and conferences for a request that didn't ask for any teams or games
+func (c Config) ApplyTrackingDefaults(req *TrackingRequest) {
+	if req.PollInterval == 0 {
+		req.PollInterval = Duration(c.PollInterval)
+	}
+	if len(req.Conferences) == 0 && len(req.Teams) == 0 && len(req.GameIDs) == 0 {
+		req.Conferences = c.Conferences
+	}
+}
+
+// Validate checks the settings every process needs to talk to Temporal
+func (c Config) Validate() error {
+	if c.TemporalHost == "" {
+		return fmt.Errorf("TEMPORAL_HOST environment variable is not set")
+	}
+	if c.TemporalNamespace == "" {
+		return fmt.Errorf("TEMPORAL_NAMESPACE environment variable is not set")
+	}
+	if !c.LocalTemporal() && c.TemporalAPIKey == "" {
+		return fmt.Errorf("TEMPORAL_API_KEY environment variable is not set")
+	}
+	return nil
+}
+
+// LocalTemporal reports whether TEMPORAL_HOST is a local dev server (no TLS or API key)
+func (c Config) LocalTemporal() bool {
+	return c.TemporalHost == "localhost:7233" || c.TemporalHost == "temporal:7233"
+}
@@ -0,0 +1,190 @@
+package sports
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadConfig(t *testing.T) {
+	tests := []struct {
+		name          string
+		env           map[string]string
+		expectedError string
+	}{
+		{
+			name: "local server",
+			env:  map[string]string{"TEMPORAL_HOST": "localhost:7233", "TEMPORAL_NAMESPACE": "default"},
+		},
+		{
+			name: "cloud with API key",
+			env:  map[string]string{"TEMPORAL_HOST": "ns.acct.tmprl.cloud:7233", "TEMPORAL_NAMESPACE": "ns.acct", "TEMPORAL_API_KEY": "key"},
+		},
+		{
+			name:          "missing host",
+			env:           map[string]string{"TEMPORAL_NAMESPACE": "default"},
+			expectedError: "TEMPORAL_HOST environment variable is not set",
+		},
+		{
+			name:          "missing namespace",
+			env:           map[string]string{"TEMPORAL_HOST": "localhost:7233"},
+			expectedError: "TEMPORAL_NAMESPACE environment variable is not set",
+		},
+		{
+			name:          "cloud without API key",
+			env:           map[string]string{"TEMPORAL_HOST": "ns.acct.tmprl.cloud:7233", "TEMPORAL_NAMESPACE": "ns.acct"},
+			expectedError: "TEMPORAL_API_KEY environment variable is not set",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for _, key := range []string{"TEMPORAL_HOST", "TEMPORAL_NAMESPACE", "TEMPORAL_API_KEY"} {
+				t.Setenv(key, tt.env[key])
+			}
+
+			cfg, err := LoadConfig()
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.env["TEMPORAL_HOST"], cfg.TemporalHost)
+			assert.Equal(t, tt.env["TEMPORAL_NAMESPACE"], cfg.TemporalNamespace)
+		})
+	}
+}
+
+func TestLoadConfig_Notifications(t *testing.T) {
+	t.Setenv("TEMPORAL_HOST", "localhost:7233")
+	t.Setenv("TEMPORAL_NAMESPACE", "default")
+	t.Setenv("TASK_QUEUE", "sports-tracker-task-queue")
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("NOTIFICATION_TYPES", "")
+		t.Setenv("NOTIFICATION_CHANNELS", "")
+		t.Setenv("SLACK_USE_BLOCKS", "")
+
+		cfg, err := LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, "sports-tracker-task-queue", cfg.TaskQueue)
+		assert.Equal(t, []string{"score_change"}, cfg.NotificationTypes)
+		assert.Equal(t, []string{"logger"}, cfg.NotificationChannels)
+		assert.False(t, cfg.SlackUseBlocks)
+	})
+
+	t.Run("set", func(t *testing.T) {
+		t.Setenv("NOTIFICATION_TYPES", "underdog,score_change")
+		t.Setenv("NOTIFICATION_CHANNELS", "logger,slack")
+		t.Setenv("SLACK_USE_BLOCKS", "true")
+
+		cfg, err := LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"underdog", "score_change"}, cfg.NotificationTypes)
+		assert.Equal(t, []string{"logger", "slack"}, cfg.NotificationChannels)
+		assert.True(t, cfg.SlackUseBlocks)
+	})
+
+	t.Run("unknown channels", func(t *testing.T) {
+		// A typo is skipped when sending rather than failing startup...
+		t.Setenv("NOTIFICATION_CHANNELS", "slack,bogus")
+		cfg, err := LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, []string{"slack", "bogus"}, cfg.NotificationChannels)
+
+		// ...unless there's nothing left
+		t.Setenv("NOTIFICATION_CHANNELS", "bogus")
+		_, err = LoadConfig()
+		assert.ErrorContains(t, err, "NOTIFICATION_CHANNELS has no known channels")
+	})
+}
+
+func TestCurrentConfig(t *testing.T) {
+	t.Setenv("HASS_WEBHOOK_URL", "http://from-env")
+
+	// Nothing set yet, so it reads the environment
+	assert.Equal(t, "http://from-env", CurrentConfig().HassWebhookURL)
+
+	// Once set at startup, the environment is no longer consulted
+	SetConfig(Config{HassWebhookURL: "http://from-config"})
+	defer func() { config = nil }()
+	assert.Equal(t, "http://from-config", CurrentConfig().HassWebhookURL)
+}
+
+func TestLoadConfig_File(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+notificationTypes: [underdog, overtime]
+notificationChannels: [slack]
+pollInterval: 6h
+conferences: ["5", "8"]
+`), 0o644))
+
+	t.Setenv("CONFIG_FILE", path)
+	t.Setenv("TEMPORAL_HOST", "localhost:7233")
+	t.Setenv("TEMPORAL_NAMESPACE", "default")
+	t.Setenv("NOTIFICATION_TYPES", "")
+	t.Setenv("CONFERENCES", "")
+	t.Setenv("POLL_INTERVAL", "")
+
+	// Env overrides the file's channels, everything else comes from the file
+	t.Setenv("NOTIFICATION_CHANNELS", "logger,hass")
+
+	cfg, err := LoadConfig()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"underdog", "overtime"}, cfg.NotificationTypes)
+	assert.Equal(t, []string{"logger", "hass"}, cfg.NotificationChannels)
+	assert.Equal(t, 6*time.Hour, cfg.PollInterval)
+	assert.Equal(t, []string{"5", "8"}, cfg.Conferences)
+
+	t.Run("env poll interval wins", func(t *testing.T) {
+		t.Setenv("POLL_INTERVAL", "30m")
+		cfg, err := LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, 30*time.Minute, cfg.PollInterval)
+	})
+
+	t.Run("bad poll interval", func(t *testing.T) {
+		t.Setenv("POLL_INTERVAL", "often")
+		_, err := LoadConfig()
+		assert.ErrorContains(t, err, "invalid POLL_INTERVAL")
+	})
+
+	t.Run("game info concurrency", func(t *testing.T) {
+		assert.Equal(t, DefaultGameInfoConcurrency, cfg.GameInfoConcurrency)
+
+		t.Setenv("GAME_INFO_CONCURRENCY", "4")
+		cfg, err := LoadConfig()
+		require.NoError(t, err)
+		assert.Equal(t, 4, cfg.GameInfoConcurrency)
+
+		t.Setenv("GAME_INFO_CONCURRENCY", "0")
+		_, err = LoadConfig()
+		assert.ErrorContains(t, err, "invalid GAME_INFO_CONCURRENCY")
+	})
+
+	t.Run("missing file", func(t *testing.T) {
+		t.Setenv("CONFIG_FILE", filepath.Join(t.TempDir(), "nope.yaml"))
+		_, err := LoadConfig()
+		assert.ErrorContains(t, err, "reading config file")
+	})
+}
+
+func TestConfig_ApplyTrackingDefaults(t *testing.T) {
+	cfg := Config{PollInterval: 6 * time.Hour, Conferences: []string{"5"}}
+
+	req := TrackingRequest{Sport: "football", League: "college-football"}
+	cfg.ApplyTrackingDefaults(&req)
+	assert.Equal(t, Duration(6*time.Hour), req.PollInterval)
+	assert.Equal(t, []string{"5"}, req.Conferences)
+
+	// Anything the request sets is kept
+	req = TrackingRequest{PollInterval: Duration(time.Hour), Teams: []string{"130"}}
+	cfg.ApplyTrackingDefaults(&req)
+	assert.Equal(t, Duration(time.Hour), req.PollInterval)
+	assert.Empty(t, req.Conferences)
+}
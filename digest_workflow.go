@@ -0,0 +1,174 @@
+package sports
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"go.temporal.io/sdk/workflow"
+)
+
+const (
+	// DigestExpectGameSignal tells a DigestWorkflow about a game (its ID, a string) to wait for before sending
+	DigestExpectGameSignal = "digestExpectGame"
+	// DigestGameResultSignal is how a digest game's GameWorkflow hands over its DigestEntry when it's done
+	DigestGameResultSignal = "digestGameResult"
+	// DigestCollectionDoneSignal tells a DigestWorkflow its collection won't be scheduling any more games. It takes no arguments.
+	DigestCollectionDoneSignal = "digestCollectionDone"
+)
+
+// If nothing's been heard for this long, a game must have gone missing - send the digest with what's in
+const digestMaxWait = 24 * time.Hour
+
+// DigestEntry is one game's part of the digest: how it finished, and the notifications it held back
+type DigestEntry struct {
+	Result        GameResult
+	Notifications []SentNotification
+}
+
+// DigestWorkflowID is the ID of the DigestWorkflow for the collection with this workflow ID
+func DigestWorkflowID(collectionWorkflowID string) string {
+	return collectionWorkflowID + "-digest"
+}
+
+// DigestWorkflow collects the results of a digest collection's games and sends them as one summary, instead of the
+// live notifications each game would have sent. It's done once the collection has signalled it's done and every
+// game it was told to expect has reported in, and returns the summary it sent.
+func DigestWorkflow(ctx workflow.Context, trackingRequest TrackingRequest) (Notification, error) {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Starting Digest Workflow")
+
+	timeouts := trackingRequest.ActivityTimeouts.withDefaults()
+	retry := trackingRequest.ActivityRetry.withDefaults()
+	notifyCtx := workflow.WithActivityOptions(ctx, newActivityOptions(timeouts.Notification, retry.CollectMaximumAttempts, retry))
+
+	var expected []string
+	var entries []DigestEntry
+	collectionDone := false
+	changed := false
+
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		expectCh := workflow.GetSignalChannel(ctx, DigestExpectGameSignal)
+		for {
+			var gameID string
+			expectCh.Receive(ctx, &gameID)
+			if !slices.Contains(expected, gameID) {
+				expected = append(expected, gameID)
+			}
+			changed = true
+		}
+	})
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		resultCh := workflow.GetSignalChannel(ctx, DigestGameResultSignal)
+		for {
+			var entry DigestEntry
+			resultCh.Receive(ctx, &entry)
+			logger.Info("Game reported to digest", "gameID", entry.Result.GameID, "notifications", len(entry.Notifications))
+			entries = append(entries, entry)
+			changed = true
+		}
+	})
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		workflow.GetSignalChannel(ctx, DigestCollectionDoneSignal).Receive(ctx, nil)
+		collectionDone = true
+		changed = true
+	})
+
+	for !collectionDone || len(missingDigestGames(expected, entries)) > 0 {
+		changed = false
+		heard, err := workflow.AwaitWithTimeout(ctx, digestMaxWait, func() bool {
+			return changed
+		})
+		if err != nil {
+			return Notification{}, err
+		}
+		if !heard {
+			logger.Warn("Gave up waiting for games, sending the digest without them", "missingGameIDs", missingDigestGames(expected, entries), "collectionDone", collectionDone)
+			break
+		}
+	}
+
+	if len(entries) == 0 {
+		logger.Info("No games to put in the digest, not sending one")
+		return Notification{}, nil
+	}
+
+	summary := buildDigestNotification(entries, len(missingDigestGames(expected, entries)))
+	sendNotificationList(ctx, notifyCtx, "", recordedNotificationChannels(ctx), []Notification{summary})
+
+	logger.Info("Digest Workflow completed", "games", len(entries))
+	return summary, nil
+}
+
+// missingDigestGames returns the expected game IDs that haven't reported in yet
+func missingDigestGames(expected []string, entries []DigestEntry) []string {
+	var missing []string
+	for _, gameID := range expected {
+		reported := slices.ContainsFunc(entries, func(entry DigestEntry) bool {
+			return entry.Result.GameID == gameID
+		})
+		if !reported {
+			missing = append(missing, gameID)
+		}
+	}
+	return missing
+}
+
+// buildDigestNotification summarizes the games, earliest first, with what each one would have sent, e.g.
+// Daily Digest: 2 games
+// Michigan Wolverines 30 - Ohio State Buckeyes 24
+// Score Update! x4, Upset Alert!
+// Georgia Bulldogs 31 - Georgia Tech Yellow Jackets 23
+func buildDigestNotification(entries []DigestEntry, missing int) Notification {
+	entries = slices.Clone(entries)
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].Result.StartTime.Before(entries[j].Result.StartTime)
+	})
+
+	noun := "games"
+	if len(entries) == 1 {
+		noun = "game"
+	}
+	var parts []string
+	for _, entry := range entries {
+		result := entry.Result
+		part := fmt.Sprintf("%s %s - %s %s", result.HomeTeam, result.HomeScore, result.AwayTeam, result.AwayScore)
+		if highlights := digestHighlights(entry.Notifications); highlights != "" {
+			part += "\n" + highlights
+		}
+		parts = append(parts, part)
+	}
+	if missing > 0 {
+		parts = append(parts, fmt.Sprintf("%d more didn't finish in time to be included", missing))
+	}
+
+	return Notification{
+		Title:    fmt.Sprintf("Daily Digest: %d %s", len(entries), noun),
+		Message:  strings.Join(parts, "\n"),
+		Priority: PriorityLow,
+	}
+}
+
+// digestHighlights lists the titles of a game's held notifications in the order they first came up, with a count
+// for any that came up more than once, e.g. "Score Update! x4, Upset Alert!"
+func digestHighlights(notifications []SentNotification) string {
+	var titles []string
+	counts := make(map[string]int)
+	for _, notification := range notifications {
+		if counts[notification.Title] == 0 {
+			titles = append(titles, notification.Title)
+		}
+		counts[notification.Title]++
+	}
+	highlights := make([]string, 0, len(titles))
+	for _, title := range titles {
+		if counts[title] > 1 {
+			highlights = append(highlights, fmt.Sprintf("%s x%d", title, counts[title]))
+		} else {
+			highlights = append(highlights, title)
+		}
+	}
+	return strings.Join(highlights, ", ")
+}
@@ -0,0 +1,141 @@
+package sports
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/testsuite"
+)
+
+func digestEntry(gameID string, startTime time.Time, homeScore string, titles ...string) DigestEntry {
+	entry := DigestEntry{Result: GameResult{
+		GameID:    gameID,
+		HomeTeam:  "Home " + gameID,
+		AwayTeam:  "Away " + gameID,
+		HomeScore: homeScore,
+		AwayScore: "0",
+		StartTime: startTime,
+	}}
+	for _, title := range titles {
+		entry.Notifications = append(entry.Notifications, SentNotification{Title: title})
+	}
+	return entry
+}
+
+func TestDigestWorkflow(t *testing.T) {
+	t.Setenv("NOTIFICATION_CHANNELS", "logger,slack")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	var sent []SendNotifications
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications)
+		return nil
+	})
+
+	// The collection schedules two games and finishes, then they report in over the afternoon
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(DigestExpectGameSignal, "401520281")
+		env.SignalWorkflow(DigestExpectGameSignal, "401520282")
+		env.SignalWorkflow(DigestCollectionDoneSignal, nil)
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(DigestGameResultSignal, digestEntry("401520282", workflowStart.Add(time.Hour), "21", "Score Update!", "Score Update!", "Final!"))
+	}, 4*time.Hour)
+	env.RegisterDelayedCallback(func() {
+		require.Empty(t, sent, "still waiting on the other game")
+		env.SignalWorkflow(DigestGameResultSignal, digestEntry("401520281", workflowStart.Add(2*time.Hour), "10", "Upset Alert!"))
+	}, 6*time.Hour)
+
+	env.ExecuteWorkflow(DigestWorkflow, TrackingRequest{Digest: true})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	var summary Notification
+	require.NoError(t, env.GetWorkflowResult(&summary))
+	assert.Equal(t, "Daily Digest: 2 games", summary.Title)
+	assert.Equal(t, "Home 401520282 21 - Away 401520282 0\nScore Update! x2, Final!\nHome 401520281 10 - Away 401520281 0\nUpset Alert!", summary.Message)
+
+	// One summary, once, to each channel
+	require.Len(t, sent, 2)
+	assert.Equal(t, []string{"logger", "slack"}, []string{sent[0].Channel, sent[1].Channel})
+	for _, sendNotifications := range sent {
+		assert.Equal(t, []Notification{summary}, sendNotifications.NotificationList)
+	}
+}
+
+func TestDigestWorkflow_GivesUpOnMissingGame(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil)
+
+	// One of the two games never reports in
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(DigestExpectGameSignal, "401520281")
+		env.SignalWorkflow(DigestExpectGameSignal, "401520282")
+		env.SignalWorkflow(DigestCollectionDoneSignal, nil)
+	}, time.Minute)
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(DigestGameResultSignal, digestEntry("401520281", workflowStart, "14"))
+	}, 4*time.Hour)
+
+	env.ExecuteWorkflow(DigestWorkflow, TrackingRequest{Digest: true})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, workflowStart.Add(4*time.Hour+digestMaxWait), env.Now().UTC())
+
+	var summary Notification
+	require.NoError(t, env.GetWorkflowResult(&summary))
+	assert.Equal(t, "Daily Digest: 1 game", summary.Title)
+	assert.Equal(t, "Home 401520281 14 - Away 401520281 0\n1 more didn't finish in time to be included", summary.Message)
+}
+
+func TestDigestWorkflow_NoGames(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	env.RegisterDelayedCallback(func() {
+		env.SignalWorkflow(DigestCollectionDoneSignal, nil)
+	}, time.Minute)
+
+	env.ExecuteWorkflow(DigestWorkflow, TrackingRequest{Digest: true})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertNotCalled(t, "SendNotificationListActivity", mock.Anything, mock.Anything)
+}
+
+func TestDigestHighlights(t *testing.T) {
+	tests := []struct {
+		name     string
+		titles   []string
+		expected string
+	}{
+		{name: "none"},
+		{name: "one", titles: []string{"Final!"}, expected: "Final!"},
+		{name: "repeats counted", titles: []string{"Score Update!", "Upset Alert!", "Score Update!", "Score Update!"}, expected: "Score Update! x3, Upset Alert!"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var notifications []SentNotification
+			for _, title := range tt.titles {
+				notifications = append(notifications, SentNotification{Title: title})
+			}
+			assert.Equal(t, tt.expected, digestHighlights(notifications))
+		})
+	}
+}
@@ -0,0 +1,48 @@
+package sports
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// Duration is a time.Duration that's a Go duration string in JSON, e.g. "15m" or "2h30m", like the durations the
+// rest of the API takes. A bare number is still read as nanoseconds, for clients that already send those.
+type Duration time.Duration
+
+// MarshalJSON implements the json.Marshaler interface.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	trimmed := bytes.TrimSpace(b)
+	if bytes.Equal(trimmed, []byte("null")) {
+		return nil
+	}
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		var s string
+		if err := json.Unmarshal(trimmed, &s); err != nil {
+			return err
+		}
+		if s == "" {
+			*d = 0
+			return nil
+		}
+		parsed, err := time.ParseDuration(s)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q, want e.g. \"15m\"", s)
+		}
+		*d = Duration(parsed)
+		return nil
+	}
+
+	var nanoseconds int64
+	if err := json.Unmarshal(trimmed, &nanoseconds); err != nil {
+		return fmt.Errorf("invalid duration %s, want e.g. \"15m\"", trimmed)
+	}
+	*d = Duration(nanoseconds)
+	return nil
+}
@@ -0,0 +1,69 @@
+package sports
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDuration_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		input         string
+		expected      Duration
+		expectedError bool
+	}{
+		{name: "duration string", input: `"15m"`, expected: Duration(15 * time.Minute)},
+		{name: "compound duration string", input: `"2h30m"`, expected: Duration(150 * time.Minute)},
+		{name: "nanoseconds from existing clients", input: `900000000000`, expected: Duration(15 * time.Minute)},
+		{name: "zero", input: `0`},
+		{name: "empty string", input: `""`},
+		{name: "null", input: `null`},
+		{name: "not a duration", input: `"fifteen minutes"`, expectedError: true},
+		{name: "fractional nanoseconds", input: `1.5`, expectedError: true},
+		{name: "wrong type", input: `true`, expectedError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var d Duration
+			err := json.Unmarshal([]byte(tt.input), &d)
+			if tt.expectedError {
+				assert.ErrorContains(t, err, "invalid duration")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, d)
+		})
+	}
+}
+
+func TestDuration_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(Duration(15 * time.Minute))
+	require.NoError(t, err)
+	assert.Equal(t, `"15m0s"`, string(data))
+
+	data, err = json.Marshal(Duration(0))
+	require.NoError(t, err)
+	assert.Equal(t, `"0s"`, string(data))
+}
+
+func TestTrackingRequest_Durations(t *testing.T) {
+	var req TrackingRequest
+	body := `{"sport": "football", "league": "nfl", "minNotifyInterval": "10m", "pollInterval": 3600000000000, "reminderLead": "15m"}`
+	require.NoError(t, json.Unmarshal([]byte(body), &req))
+	assert.Equal(t, Duration(10*time.Minute), req.MinNotifyInterval)
+	assert.Equal(t, Duration(time.Hour), req.PollInterval)
+	assert.Equal(t, Duration(15*time.Minute), req.ReminderLead)
+
+	// Round trips through Temporal's JSON payloads, e.g. across Continue-As-New
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"pollInterval":"1h0m0s"`)
+	var roundTripped TrackingRequest
+	require.NoError(t, json.Unmarshal(data, &roundTripped))
+	assert.Equal(t, req, roundTripped)
+}
@@ -0,0 +1,192 @@
+package sports
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"os"
+	"strconv"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+// Error types returned by ESPNClient, so workflows (and Temporal's retry policy) can tell them apart
+const (
+	ESPNRequestErrorType     = "ESPNRequestError" // 4xx like an unknown league - retrying won't help
+	ESPNUnavailableErrorType = "ESPNUnavailable"  // 429/5xx - ESPN is having a moment, worth retrying
+)
+
+// Retries within a single request, before the activity attempt fails and Temporal's retry policy takes over
+const (
+	defaultESPNHTTPRetries = 2
+	defaultESPNRetryDelay  = 250 * time.Millisecond
+)
+
+// espnDebugBodyLimit is how much of a response that didn't parse is kept on its ESPNDecodeError
+const espnDebugBodyLimit = 2048
+
+// ESPNDecodeError is returned by GetJSON when ESPN's response doesn't parse, with the start of the body so the
+// activities can log it when ESPN changes shape
+type ESPNDecodeError struct {
+	URL  string
+	Body string // The first espnDebugBodyLimit bytes of the response
+	Size int    // Length of the whole response
+	Err  error
+}
+
+func (e *ESPNDecodeError) Error() string {
+	return fmt.Sprintf("failed to unmarshal ESPN response: %v", e.Err)
+}
+
+func (e *ESPNDecodeError) Unwrap() error {
+	return e.Err
+}
+
+// ESPNClient wraps the calls we make to ESPN's public site API
+type ESPNClient struct {
+	BaseURL    string // e.g. "https://site.api.espn.com/apis/site/v2/sports"
+	HTTPClient *http.Client
+	Retries    int             // Extra tries on connection errors and 5xx responses
+	RetryDelay time.Duration   // Wait before the first of those tries, doubling after each one
+	Breaker    *circuitBreaker // Shared by every call made through this client; nil means no breaker
+	Debug      bool            // The activities log the body of any response that doesn't parse
+}
+
+// NewESPNClient creates a client for baseURL. Retries come from ESPN_HTTP_RETRIES (default 2), and ESPN_DEBUG=true
+// turns on Debug.
+func NewESPNClient(baseURL string) *ESPNClient {
+	return &ESPNClient{
+		BaseURL:    baseURL,
+		HTTPClient: &http.Client{Timeout: 20 * time.Second},
+		Retries:    espnHTTPRetriesFromEnv(),
+		RetryDelay: defaultESPNRetryDelay,
+		Breaker:    newCircuitBreaker(defaultESPNBreakerFailures, defaultESPNBreakerCooldown),
+		Debug:      os.Getenv("ESPN_DEBUG") == "true",
+	}
+}
+
+func espnHTTPRetriesFromEnv() int {
+	retriesStr := os.Getenv("ESPN_HTTP_RETRIES")
+	if retriesStr == "" {
+		return defaultESPNHTTPRetries
+	}
+	retries, err := strconv.Atoi(retriesStr)
+	if err != nil || retries < 0 {
+		slog.Warn("Ignoring invalid ESPN_HTTP_RETRIES, using the default", "value", retriesStr, "default", defaultESPNHTTPRetries)
+		return defaultESPNHTTPRetries
+	}
+	return retries
+}
+
+// DefaultESPNClient is used by the activities. Tests can point it at an httptest server.
+var DefaultESPNClient = NewESPNClient("https://site.api.espn.com/apis/site/v2/sports")
+
+// APIRoot returns the base URL for a sport/league, e.g. ".../sports/football/college-football"
+func (c *ESPNClient) APIRoot(sport string, league string) string {
+	return fmt.Sprintf("%s/%s/%s", c.BaseURL, sport, league)
+}
+
+// GetJSON fetches url and decodes the JSON body into v.
+// Connection errors and 5xx responses are retried up to Retries times, with exponential backoff, before giving up.
+// Non-200 responses are classified: 4xx (other than 429) come back as non-retryable application errors,
+// while 429 and 5xx come back as retryable ones. Network and decode errors are left retryable.
+// While the circuit breaker is open, calls fail straight away with a retryable ESPNUnavailable error.
+func (c *ESPNClient) GetJSON(ctx context.Context, url string, v any) error {
+	if c.Breaker != nil {
+		if err := c.Breaker.allow(url); err != nil {
+			return err
+		}
+	}
+
+	body, err := c.getWithRetries(ctx, url)
+	if c.Breaker != nil {
+		if ctx.Err() != nil {
+			// Our own cancellation says nothing about ESPN, but it mustn't leave a trial call outstanding
+			c.Breaker.abandon()
+		} else if c.Breaker.record(err != nil && !isESPNRequestError(err)) {
+			// Only ESPN being unreachable or unhealthy counts against it - a 4xx means we asked for something wrong
+			slog.Warn("ESPN keeps failing, opening the circuit breaker", "url", url, "failures", c.Breaker.Failures, "cooldown", c.Breaker.Cooldown, "error", err)
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return &ESPNDecodeError{URL: url, Body: truncateBody(body, espnDebugBodyLimit), Size: len(body), Err: err}
+	}
+	return nil
+}
+
+// truncateBody returns body as a string, cut off after limit bytes
+func truncateBody(body []byte, limit int) string {
+	if len(body) <= limit {
+		return string(body)
+	}
+	return string(body[:limit]) + "...(truncated)"
+}
+
+// getWithRetries makes the request, retrying connection errors and 5xx responses up to Retries times
+func (c *ESPNClient) getWithRetries(ctx context.Context, url string) ([]byte, error) {
+	var body []byte
+	var err error
+	delay := c.RetryDelay
+	for attempt := 0; ; attempt++ {
+		var retryable bool
+		body, retryable, err = c.get(ctx, url)
+		if err == nil || !retryable || attempt >= c.Retries {
+			break
+		}
+		slog.Warn("ESPN request failed, retrying", "url", url, "attempt", attempt+1, "error", err)
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+	return body, err
+}
+
+// isESPNRequestError reports whether err is ESPN rejecting the request itself (a 4xx other than 429)
+func isESPNRequestError(err error) bool {
+	var appErr *temporal.ApplicationError
+	return errors.As(err, &appErr) && appErr.Type() == ESPNRequestErrorType
+}
+
+// get makes a single request and returns the body, along with whether a failure is worth retrying right away
+func (c *ESPNClient) get(ctx context.Context, url string) ([]byte, bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to create ESPN request: %w", err)
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, ctx.Err() == nil, fmt.Errorf("failed to fetch from ESPN: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, resp.StatusCode >= 500, classifyESPNStatus(url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, true, fmt.Errorf("failed to read response body: %w", err)
+	}
+	return body, false, nil
+}
+
+func classifyESPNStatus(url string, statusCode int) error {
+	message := fmt.Sprintf("ESPN returned %d %s for %s", statusCode, http.StatusText(statusCode), url)
+	if statusCode == http.StatusTooManyRequests || statusCode >= 500 {
+		return temporal.NewApplicationError(message, ESPNUnavailableErrorType)
+	}
+	return temporal.NewNonRetryableApplicationError(message, ESPNRequestErrorType, nil)
+}
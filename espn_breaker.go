@@ -0,0 +1,80 @@
+package sports
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+// Breaker defaults: after this many ESPN calls in a row fail, stop calling for the cooldown
+const (
+	defaultESPNBreakerFailures = 5
+	defaultESPNBreakerCooldown = time.Minute
+)
+
+// circuitBreaker stops every tracked game hammering ESPN while it's down. It opens after Failures consecutive
+// failed calls and short-circuits calls until Cooldown has passed. It's then half-open: exactly one call is let
+// through as a trial while the rest keep failing fast. A success closes the breaker, another failure opens it again
+// for another cooldown.
+type circuitBreaker struct {
+	Failures int
+	Cooldown time.Duration
+
+	mu                  sync.Mutex
+	consecutiveFailures int
+	openUntil           time.Time
+	probing             bool             // A trial call is in flight after the cooldown
+	now                 func() time.Time // time.Now, swapped out in tests
+}
+
+func newCircuitBreaker(failures int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{Failures: failures, Cooldown: cooldown, now: time.Now}
+}
+
+// allow returns a retryable ESPNUnavailable error without touching the network if the breaker is open, or if it's
+// half-open and another call is already the trial. A call that's allowed must be followed by record or abandon.
+func (b *circuitBreaker) allow(url string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.now().Before(b.openUntil) {
+		message := fmt.Sprintf("ESPN circuit breaker is open until %s, not calling %s", b.openUntil.Format(time.RFC3339), url)
+		return temporal.NewApplicationError(message, ESPNUnavailableErrorType)
+	}
+	if b.probing {
+		message := fmt.Sprintf("ESPN circuit breaker is half-open and a trial call is in flight, not calling %s", url)
+		return temporal.NewApplicationError(message, ESPNUnavailableErrorType)
+	}
+	if b.consecutiveFailures >= b.Failures {
+		b.probing = true
+	}
+	return nil
+}
+
+// record counts the outcome of a call that was let through
+func (b *circuitBreaker) record(failed bool) (opened bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !failed {
+		b.consecutiveFailures = 0
+		return false
+	}
+	b.consecutiveFailures++
+	if b.consecutiveFailures < b.Failures {
+		return false
+	}
+	b.openUntil = b.now().Add(b.Cooldown)
+	return true
+}
+
+// abandon releases a call that was let through without counting its outcome, e.g. because its context was cancelled
+func (b *circuitBreaker) abandon() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+}
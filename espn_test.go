@@ -0,0 +1,335 @@
+package sports
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/testsuite"
+)
+
+// flakyTransport fails its first `failures` round trips at the transport level, then passes requests through
+type flakyTransport struct {
+	failures int
+	attempts int
+}
+
+func (f *flakyTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.attempts++
+	if f.attempts <= f.failures {
+		return nil, errors.New("connection reset by peer")
+	}
+	return http.DefaultTransport.RoundTrip(req)
+}
+
+func TestESPNClient_RetriesTransportErrors(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events": [{"id": "401520281"}]}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		failures         int
+		expectedError    bool
+		expectedAttempts int
+	}{
+		{name: "succeeds on the third attempt", failures: 2, expectedAttempts: 3},
+		{name: "gives up after the retries", failures: 3, expectedError: true, expectedAttempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &flakyTransport{failures: tt.failures}
+			espnClient := NewESPNClient(server.URL)
+			espnClient.HTTPClient = &http.Client{Transport: transport}
+			espnClient.Retries = 2
+			espnClient.RetryDelay = time.Millisecond
+
+			var espnResp ESPNResponse
+			err := espnClient.GetJSON(context.Background(), server.URL+"/football/nfl/scoreboard", &espnResp)
+			assert.Equal(t, tt.expectedAttempts, transport.attempts)
+			if tt.expectedError {
+				assert.ErrorContains(t, err, "connection reset by peer")
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, espnResp.Events, 1)
+			assert.Equal(t, "401520281", espnResp.Events[0].ID)
+		})
+	}
+}
+
+func TestESPNClient_RetriesServerErrorsOnly(t *testing.T) {
+	tests := []struct {
+		name             string
+		statusCode       int
+		expectedRequests int
+	}{
+		{name: "5xx is retried", statusCode: http.StatusBadGateway, expectedRequests: 3},
+		{name: "4xx is not", statusCode: http.StatusNotFound, expectedRequests: 1},
+		{name: "429 is left to Temporal", statusCode: http.StatusTooManyRequests, expectedRequests: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			espnClient := NewESPNClient(server.URL)
+			espnClient.Retries = 2
+			espnClient.RetryDelay = time.Millisecond
+
+			var espnResp ESPNResponse
+			err := espnClient.GetJSON(context.Background(), server.URL+"/football/nfl/scoreboard", &espnResp)
+			assert.Error(t, err)
+			assert.Equal(t, tt.expectedRequests, requests)
+		})
+	}
+}
+
+func TestESPNHTTPRetriesFromEnv(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected int
+	}{
+		{"", defaultESPNHTTPRetries},
+		{"0", 0},
+		{"5", 5},
+		{"lots", defaultESPNHTTPRetries},
+		{"-1", defaultESPNHTTPRetries},
+	}
+
+	for _, tt := range tests {
+		t.Setenv("ESPN_HTTP_RETRIES", tt.value)
+		assert.Equal(t, tt.expected, espnHTTPRetriesFromEnv(), "ESPN_HTTP_RETRIES=%q", tt.value)
+	}
+}
+
+func TestESPNDebug_LogsMalformedResponse(t *testing.T) {
+	// ESPN has started sending competitors as an object instead of a list
+	malformed := `{"events": [{"id": "401628374", "competitions": [{"id": "401628374", "competitors": {"home": "MICH"}}]}]}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(malformed))
+	}))
+	defer server.Close()
+
+	// Logged by the activity, so it carries the workflow and activity it came from
+	logger := &recordingLogger{Logger: log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), message: "ESPN response didn't parse"}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(logger)
+	env := testSuite.NewTestActivityEnvironment()
+	env.RegisterActivity(GetGamesActivity)
+	env.RegisterActivity(GetGameScoreActivity)
+
+	activities := []struct {
+		name string
+		run  func() error
+	}{
+		{"GetGamesActivity", func() error {
+			_, err := env.ExecuteActivity(GetGamesActivity, TrackingRequest{Sport: "football", League: "college-football", Conferences: []string{"5"}})
+			return err
+		}},
+		{"GetGameScoreActivity", func() error {
+			_, err := env.ExecuteActivity(GetGameScoreActivity, Game{ID: "401628374", APIRoot: server.URL + "/football/college-football"})
+			return err
+		}},
+	}
+
+	for _, debug := range []string{"true", ""} {
+		for _, activity := range activities {
+			t.Run(activity.name+" ESPN_DEBUG="+debug, func(t *testing.T) {
+				t.Setenv("ESPN_DEBUG", debug)
+				originalClient := DefaultESPNClient
+				DefaultESPNClient = NewESPNClient(server.URL)
+				defer func() { DefaultESPNClient = originalClient }()
+				logger.lines = nil
+
+				err := activity.run()
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "failed to unmarshal ESPN response")
+				if debug == "true" {
+					require.Len(t, logger.lines, 1)
+					assert.Contains(t, keyval(logger.lines[0], "body"), `"competitors": {"home": "MICH"}`)
+				} else {
+					assert.Empty(t, logger.lines)
+				}
+			})
+		}
+	}
+}
+
+func TestESPNClient_DecodeError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"events": {}}`))
+	}))
+	defer server.Close()
+
+	// The client doesn't log it itself - the web handlers share it, and only the activities log the body
+	espnClient := NewESPNClient(server.URL)
+	espnClient.Debug = true
+	url := server.URL + "/football/nfl/scoreboard"
+	var espnResp ESPNResponse
+	err := espnClient.GetJSON(context.Background(), url, &espnResp)
+
+	var decodeErr *ESPNDecodeError
+	require.ErrorAs(t, err, &decodeErr)
+	assert.Equal(t, url, decodeErr.URL)
+	assert.Equal(t, `{"events": {}}`, decodeErr.Body)
+	assert.Equal(t, 14, decodeErr.Size)
+}
+
+func TestTruncateBody(t *testing.T) {
+	assert.Equal(t, "short", truncateBody([]byte("short"), 10))
+	assert.Equal(t, "exactly10!", truncateBody([]byte("exactly10!"), 10))
+	assert.Equal(t, "too long, ...(truncated)", truncateBody([]byte("too long, by a lot"), 10))
+}
+
+func TestESPNClient_CircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name            string
+		statusCode      int
+		expectedOpen    bool
+		expectedErrType string
+	}{
+		{name: "5xx opens the breaker", statusCode: http.StatusServiceUnavailable, expectedOpen: true, expectedErrType: ESPNUnavailableErrorType},
+		{name: "4xx doesn't count against ESPN", statusCode: http.StatusNotFound, expectedErrType: ESPNRequestErrorType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+			healthy := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if healthy {
+					w.Write([]byte(`{"events": []}`))
+					return
+				}
+				w.WriteHeader(tt.statusCode)
+			}))
+			defer server.Close()
+
+			now := time.Date(2025, 9, 6, 12, 0, 0, 0, time.UTC)
+			espnClient := NewESPNClient(server.URL)
+			espnClient.Retries = 0
+			espnClient.Breaker = newCircuitBreaker(3, time.Minute)
+			espnClient.Breaker.now = func() time.Time { return now }
+			url := server.URL + "/football/nfl/scoreboard"
+
+			var espnResp ESPNResponse
+			for range 3 {
+				require.Error(t, espnClient.GetJSON(context.Background(), url, &espnResp))
+			}
+			require.Equal(t, 3, requests)
+
+			// The next call is short-circuited if the breaker opened, and the error is still worth retrying later
+			err := espnClient.GetJSON(context.Background(), url, &espnResp)
+			var appErr *temporal.ApplicationError
+			require.ErrorAs(t, err, &appErr)
+			if !tt.expectedOpen {
+				assert.Equal(t, 4, requests)
+				assert.Equal(t, tt.expectedErrType, appErr.Type())
+				return
+			}
+			assert.Equal(t, 3, requests, "an open breaker shouldn't call ESPN")
+			assert.Equal(t, ESPNUnavailableErrorType, appErr.Type())
+			assert.False(t, appErr.NonRetryable())
+			assert.Contains(t, err.Error(), "circuit breaker is open")
+
+			// After the cooldown a trial call goes through, and a success closes the breaker
+			now = now.Add(time.Minute)
+			healthy = true
+			require.NoError(t, espnClient.GetJSON(context.Background(), url, &espnResp))
+			require.NoError(t, espnClient.GetJSON(context.Background(), url, &espnResp))
+			assert.Equal(t, 5, requests)
+		})
+	}
+}
+
+func TestESPNClient_CircuitBreakerHalfOpen(t *testing.T) {
+	var requests atomic.Int32
+	healthy := make(chan struct{})
+	trialStarted := make(chan struct{}, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if requests.Add(1) <= 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		trialStarted <- struct{}{}
+		<-healthy
+		w.Write([]byte(`{"events": []}`))
+	}))
+	defer server.Close()
+
+	now := time.Date(2025, 9, 6, 12, 0, 0, 0, time.UTC)
+	espnClient := NewESPNClient(server.URL)
+	espnClient.Retries = 0
+	espnClient.Breaker = newCircuitBreaker(3, time.Minute)
+	espnClient.Breaker.now = func() time.Time { return now }
+	url := server.URL + "/football/nfl/scoreboard"
+
+	var espnResp ESPNResponse
+	for range 3 {
+		require.Error(t, espnClient.GetJSON(context.Background(), url, &espnResp))
+	}
+
+	// Once the cooldown has passed, every game polling at once must still only send ESPN a single trial call
+	now = now.Add(time.Minute)
+	const callers = 10
+	errs := make(chan error, callers)
+	for range callers {
+		go func() {
+			var resp ESPNResponse
+			errs <- espnClient.GetJSON(context.Background(), url, &resp)
+		}()
+	}
+
+	<-trialStarted
+	for range callers - 1 {
+		err := <-errs
+		var appErr *temporal.ApplicationError
+		require.ErrorAs(t, err, &appErr)
+		assert.Equal(t, ESPNUnavailableErrorType, appErr.Type())
+		assert.Contains(t, err.Error(), "half-open")
+	}
+	assert.Equal(t, int32(4), requests.Load())
+
+	// The trial succeeding closes the breaker for everyone
+	close(healthy)
+	require.NoError(t, <-errs)
+	require.NoError(t, espnClient.GetJSON(context.Background(), url, &espnResp))
+	assert.Equal(t, int32(5), requests.Load())
+}
+
+func TestCircuitBreaker_AbandonedTrial(t *testing.T) {
+	now := time.Date(2025, 9, 6, 12, 0, 0, 0, time.UTC)
+	breaker := newCircuitBreaker(1, time.Minute)
+	breaker.now = func() time.Time { return now }
+	require.NoError(t, breaker.allow("u"))
+	require.True(t, breaker.record(true))
+
+	now = now.Add(time.Minute)
+	require.NoError(t, breaker.allow("u"))
+	require.Error(t, breaker.allow("u"), "only one trial call at a time")
+
+	// A trial whose context was cancelled frees the slot without closing or reopening the breaker
+	breaker.abandon()
+	require.NoError(t, breaker.allow("u"))
+	require.True(t, breaker.record(true), "a failed trial opens the breaker again")
+	require.Error(t, breaker.allow("u"))
+}
@@ -1,9 +1,12 @@
 package sports
 
 import (
+	"errors"
 	"fmt"
-	"os"
+	"maps"
+	"math/rand"
 	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -12,13 +15,68 @@ import (
 	"go.temporal.io/sdk/workflow"
 )
 
+// Search attributes set on every GameWorkflow so the UI can filter server-side.
+// These have to be registered with the Temporal server (as Keyword) before running the worker - see the README.
+var (
+	SportSearchAttribute      = temporal.NewSearchAttributeKeyKeyword("Sport")
+	LeagueSearchAttribute     = temporal.NewSearchAttributeKeyKeyword("League")
+	HomeTeamIDSearchAttribute = temporal.NewSearchAttributeKeyKeyword("HomeTeamID")
+	AwayTeamIDSearchAttribute = temporal.NewSearchAttributeKeyKeyword("AwayTeamID")
+)
+
+// SetPollIntervalUpdate changes how often a running GameWorkflow checks the score. It takes a time.Duration and
+// returns the new interval.
+const SetPollIntervalUpdate = "setPollInterval"
+
+// StopTrackingSignal asks a running GameWorkflow to stop monitoring and finish normally - anything held for batching
+// is still sent and the result still recorded, unlike cancelling it. It takes no arguments.
+const StopTrackingSignal = "stopTracking"
+
+// NotificationHistoryQuery returns the notifications a GameWorkflow has sent, as []SentNotification
+const NotificationHistoryQuery = "notificationHistory"
+
+// UpdateStartTimeSignal gives a GameWorkflow a corrected start time (a time.Time), for when ESPN moves a kickoff
+// after the game was scheduled. Before the game it re-times the wait, starting monitoring right away if the new time
+// has already passed; either way the monitoring window moves with it.
+const UpdateStartTimeSignal = "updateStartTime"
+
+// MinPollInterval is the shortest poll interval a running game can be changed to, to go easy on ESPN
+const MinPollInterval = 30 * time.Second
+
+const (
+	pollInterval  = 5 * time.Minute
+	maxPollJitter = time.Minute // The first poll lands somewhere in [pollInterval, pollInterval+maxPollJitter)
+	// comeback alerts fire when a team that trailed by at least this many points takes the lead, unless the game sets its own
+	defaultComebackMargin = 14
+	// final_minutes alerts fire once the clock in the last period gets under this
+	finalMinutes = 2 * time.Minute
+	// ESPN sometimes marks a game final and then takes it back (a review, a stat correction), so it has to stay
+	// final for this many polls in a row before we believe it
+	defaultFinalConfirmPolls = 2
+	// win_probability alerts fire when a team's chance of winning crosses this, unless the game sets its own
+	defaultWinProbabilityThreshold = 0.5
+	// Periods between underdog alerts, unless the game sets its own
+	defaultUnderdogCooldownPeriods = 1
+)
+
 // GameWorkflow monitors a single game and sends notifications on score changes
 func GameWorkflow(ctx workflow.Context, game Game) (string, error) {
 	logger := workflow.GetLogger(ctx)
 	logger.Info("Starting Game Workflow", "gameID", game.ID, "homeTeam", game.HomeTeam.DisplayName, "awayTeam", game.AwayTeam.DisplayName)
 
+	err := workflow.UpsertTypedSearchAttributes(ctx,
+		SportSearchAttribute.ValueSet(game.Sport),
+		LeagueSearchAttribute.ValueSet(game.League),
+		HomeTeamIDSearchAttribute.ValueSet(game.HomeTeam.ID),
+		AwayTeamIDSearchAttribute.ValueSet(game.AwayTeam.ID),
+	)
+	if err != nil {
+		logger.Error("Failed to upsert search attributes", "error", err)
+		return "", err
+	}
+
 	// Query handler for UI - return the game info
-	err := workflow.SetQueryHandler(ctx, "gameInfo", func() (Game, error) {
+	err = workflow.SetQueryHandler(ctx, "gameInfo", func() (Game, error) {
 		return game, nil
 	})
 	if err != nil {
@@ -26,89 +84,230 @@ func GameWorkflow(ctx workflow.Context, game Game) (string, error) {
 		return "", err
 	}
 
-	// Set up activity options with retry policy
-	activityOptions := workflow.ActivityOptions{
-		StartToCloseTimeout: 30 * time.Second,
-		RetryPolicy: &temporal.RetryPolicy{
-			InitialInterval:    time.Second,
-			BackoffCoefficient: 2.0,
-			MaximumInterval:    30 * time.Second,
-			MaximumAttempts:    5,
+	// Query handler for the UI's countdown - when the next score check happens
+	var nextPollTime time.Time
+	err = workflow.SetQueryHandler(ctx, "nextPoll", func() (time.Time, error) {
+		return nextPollTime, nil
+	})
+	if err != nil {
+		logger.Error("Failed to set query handler", "error", err)
+		return "", err
+	}
+
+	// Query handler for a per-game audit trail of what was sent, and where
+	err = workflow.SetQueryHandler(ctx, NotificationHistoryQuery, func() ([]SentNotification, error) {
+		return game.NotificationHistory, nil
+	})
+	if err != nil {
+		logger.Error("Failed to set query handler", "error", err)
+		return "", err
+	}
+
+	// Update handler to change how often the score is checked. Unlike a signal, the caller hears back - with the
+	// new interval once it's in effect, or the validator's error if it's too short.
+	pollIntervalChanged := false
+	err = workflow.SetUpdateHandlerWithOptions(ctx, SetPollIntervalUpdate,
+		func(ctx workflow.Context, interval time.Duration) (time.Duration, error) {
+			logger.Info("Poll interval changed", "gameID", game.ID, "pollInterval", interval)
+			game.PollInterval = interval
+			pollIntervalChanged = true
+			return interval, nil
 		},
+		workflow.UpdateHandlerOptions{Validator: validatePollInterval},
+	)
+	if err != nil {
+		logger.Error("Failed to set update handler", "error", err)
+		return "", err
 	}
-	ctx = workflow.WithActivityOptions(ctx, activityOptions)
-
-	// Wait until game starts
-	gameStartTime := game.StartTime
-	if gameStartTime.After(workflow.Now(ctx)) {
-		logger.Info("Waiting for game to start", "gameID", game.ID, "startTime", gameStartTime)
-		timerCtx, cancelTimer := workflow.WithCancel(ctx)
-		timer := workflow.NewTimer(timerCtx, gameStartTime.Sub(workflow.Now(ctx)))
-		selector := workflow.NewSelector(ctx)
-		selector.AddFuture(timer, func(f workflow.Future) {
-			// Timer fired, game should be starting
-		})
-		selector.Select(ctx)
-		cancelTimer()
+
+	// stopTracking can come at any point, so listen for it for the whole run
+	stopRequested := false
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		workflow.GetSignalChannel(ctx, StopTrackingSignal).Receive(ctx, nil)
+		logger.Info("Stop tracking requested", "gameID", game.ID)
+		stopRequested = true
+	})
+
+	// Set up activity options with retry policy, with a separate timeout for each activity
+	timeouts := game.ActivityTimeouts.withDefaults()
+	retry := game.ActivityRetry.withDefaults()
+	scoreCtx := workflow.WithActivityOptions(ctx, newActivityOptions(timeouts.GetGameScore, retry.GameMaximumAttempts, retry))
+	notifyCtx := workflow.WithActivityOptions(ctx, newActivityOptions(timeouts.Notification, retry.GameMaximumAttempts, retry))
+
+	// Grab notification types and channels requested - kept on the game so they carry over with the workflow input
+	game.NotificationTypes, game.NotificationChannels = notificationSettings(ctx, game)
+	notificationTypes := game.NotificationTypes
+	notificationChannels := game.NotificationChannels
+
+	// updateStartTime moves the kickoff when ESPN corrects it - before the game, the wait below is re-timed for it
+	startTimeChanged := false
+	workflow.Go(ctx, func(ctx workflow.Context) {
+		startTimeCh := workflow.GetSignalChannel(ctx, UpdateStartTimeSignal)
+		for {
+			var startTime time.Time
+			startTimeCh.Receive(ctx, &startTime)
+			logger.Info("Start time updated", "gameID", game.ID, "oldStartTime", game.StartTime, "startTime", startTime)
+			game.StartTime = startTime
+			startTimeChanged = true
+		}
+	})
+
+	// Wait until game starts, unless we've been told ESPN's start time can't be trusted
+	if game.StartImmediately && game.StartTime.After(workflow.Now(ctx)) {
+		logger.Info("Skipping the wait for game start", "gameID", game.ID, "startTime", game.StartTime)
+	} else if game.StartTime.After(workflow.Now(ctx)) {
+		logger.Info("Waiting for game to start", "gameID", game.ID, "startTime", game.StartTime)
+
+		// The reminder goes out ReminderLead before the start, or right away if the game was scheduled closer in than that
+		reminderSent := game.ReminderLead <= 0
+		for !stopRequested && game.StartTime.After(workflow.Now(ctx)) {
+			nextPollTime = game.StartTime.Add(pollInterval) // best guess until we know the jitter
+			wakeTime := game.StartTime
+			if !reminderSent {
+				wakeTime = game.StartTime.Add(-game.ReminderLead)
+			}
+
+			// A new start time cuts the wait short, and the next time around waits for that one instead
+			startTimeChanged = false
+			_, err := workflow.AwaitWithTimeout(ctx, max(wakeTime.Sub(workflow.Now(ctx)), 0), func() bool {
+				return startTimeChanged || stopRequested
+			})
+			if err != nil {
+				return "", err
+			}
+			if startTimeChanged || stopRequested || reminderSent {
+				continue
+			}
+
+			location, err := time.LoadLocation(game.Timezone)
+			if err != nil {
+				logger.Warn("Unknown timezone, giving the start time in UTC", "gameID", game.ID, "timezone", game.Timezone)
+				location = time.UTC
+			}
+			reminder := buildReminderNotification(game, game.StartTime.Sub(workflow.Now(ctx)), location)
+			deliverNotifications(ctx, notifyCtx, &game, notificationChannels, []Notification{reminder})
+			reminderSent = true
+		}
 	}
 
 	logger.Info("Game monitoring started", "gameID", game.ID)
+	monitorStart := workflow.Now(ctx)
 
-	// Grab notification types and channels requested
-	notificationTypesStr := os.Getenv("NOTIFICATION_TYPES")
-	var notificationTypes []string
-	if notificationTypesStr == "" {
-		notificationTypes = []string{"score_change"} // if not set, default to notifying if the score changes
-	} else {
-		notificationTypes = strings.Split(notificationTypesStr, ",")
-	}
+	// Initialize score tracking - the score as of the last change we saw, to diff each poll against
+	lastScores := Game{CurrentScore: maps.Clone(game.CurrentScore)}
 
-	notificationChannelsStr := os.Getenv("NOTIFICATION_CHANNELS")
-	var notificationChannels []string
-	if notificationChannelsStr == "" {
-		notificationChannels = []string{"logger"} // if not set, default to just logging the message
-	} else {
-		notificationChannels = strings.Split(notificationChannelsStr, ",")
+	// MinScoreDelta is measured from the last score_change alert, or from the score we started with
+	if game.LastNotifiedScore == nil {
+		game.LastNotifiedScore = maps.Clone(game.CurrentScore)
 	}
 
-	// Initialize score tracking
-	lastScores := make(map[string]string)
-	for teamID, score := range game.CurrentScore {
-		lastScores[teamID] = score
+	// Underdog alerts go by ESPN's odds, which plenty of games (lower-profile matchups, a lot of women's sports) don't
+	// have - say so once rather than leave people wondering why they never fire
+	if slices.Contains(notificationTypes, "underdog") && determineUnderdog(game) == "No underdog." {
+		logger.Warn("No odds for this game, underdog alerts are disabled for it", "gameID", game.ID,
+			"homeTeam", game.HomeTeam.DisplayName, "awayTeam", game.AwayTeam.DisplayName)
 	}
 
+	// Score and underdog alerts can be limited to games with one of the focus teams in them
+	focusTeamPlaying := focusTeamInGame(game)
+
 	// Initialize overtime tracking to the number of regulation periods in the game
 	lastOvertimePeriod := game.NumberOfPeriods
 
+	// Which team was last past the win probability threshold ("home" or "away"), for win_probability alerts
+	winProbabilityThreshold := game.WinProbabilityThreshold
+	if winProbabilityThreshold <= 0 || winProbabilityThreshold >= 1 {
+		winProbabilityThreshold = defaultWinProbabilityThreshold
+	}
+	lastWinProbabilityLeader := ""
+
+	// final_minutes only ever fires once per game
+	finalMinutesNotified := false
+
+	// Polls in a row that ESPN has reported the game as final
+	finalPolls := 0
+	finalConfirmPolls := game.FinalConfirmPolls
+	if finalConfirmPolls <= 0 {
+		finalConfirmPolls = defaultFinalConfirmPolls
+	}
+
+	// Plays that were already on the board when a game is picked up part way through aren't news, so the first
+	// scoring_play check just catches up to them
+	scoringPlaysCaughtUp := game.LastScoringPlayID != "" || scoreDelta(nil, game.CurrentScore) == 0
+
+	// Notifications held back for one poll when BatchNotifications is on
+	var pendingNotifications []Notification
+
+	// Every game in a collection starts polling at the same moment, so shift this game's polls by a random offset
+	// to keep them from all hitting ESPN at once. SideEffect records the offset so replays get the same one.
+	var pollJitter time.Duration
+	err = workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		return time.Duration(rand.Int63n(int64(maxPollJitter)))
+	}).Get(&pollJitter)
+	if err != nil {
+		logger.Error("Failed to pick poll jitter", "error", err)
+		return "", err
+	}
+
 	// Monitor the game for 5 hours after start time - could be modified to check for the game status instead
-	for workflow.Now(ctx).Before(game.StartTime.Add(5 * time.Hour)) {
-		// Wait 5 minutes before next poll
-		timer := workflow.NewTimer(ctx, 5*time.Minute)
-		selector := workflow.NewSelector(ctx)
-		selector.AddFuture(timer, func(f workflow.Future) {
-			// Timer fired, time to poll again
-		})
-		selector.Select(ctx)
+	nextPoll := gamePollInterval(game) + pollJitter
+	for !stopRequested && workflow.Now(ctx).Before(game.StartTime.Add(5 * time.Hour)) {
+		// Wait 5 minutes (or the game's own interval) before next poll, plus the jitter the first time around
+		waitStart := workflow.Now(ctx)
+		nextPollTime = waitStart.Add(nextPoll)
+		for {
+			changed, err := workflow.AwaitWithTimeout(ctx, max(nextPollTime.Sub(workflow.Now(ctx)), 0), func() bool {
+				return pollIntervalChanged || stopRequested
+			})
+			if err != nil {
+				return "", err
+			}
+			if !changed || stopRequested {
+				break // time to poll again, or to stop
+			}
+			// Re-time this wait too, so going from 5 minutes to 1 doesn't mean waiting out the 5
+			pollIntervalChanged = false
+			nextPollTime = waitStart.Add(game.PollInterval)
+		}
+		if stopRequested {
+			break
+		}
+		nextPoll = gamePollInterval(game)
 
 		var gameUpdate Game
-		err := workflow.ExecuteActivity(ctx, GetGameScoreActivity, game).Get(ctx, &gameUpdate)
+		err := workflow.ExecuteActivity(scoreCtx, GetGameScoreActivity, game).Get(ctx, &gameUpdate)
 		if err != nil {
+			var appErr *temporal.ApplicationError
+			if errors.As(err, &appErr) && appErr.Type() == GameNotFoundErrorType {
+				logger.Info("Game is no longer on the scoreboard, treating it as final", "gameID", game.ID)
+				break
+			}
 			logger.Error("Failed to fetch game score", "gameID", game.ID, "error", err)
 			continue
 		}
 
 		game.CurrentScore = gameUpdate.CurrentScore
+		game.LineScores = gameUpdate.LineScores
 		game.CurrentPeriod = gameUpdate.CurrentPeriod
 		game.DisplayClock = gameUpdate.DisplayClock
+		game.StatusDetail = gameUpdate.StatusDetail
+		if gameUpdate.Status != "" {
+			game.Status = gameUpdate.Status
+		}
+		if missing := missingScoreTeams(game); len(missing) > 0 {
+			logger.Warn("Score update is missing teams, notifications will show no score for them", "gameID", game.ID, "missingTeamIDs", missing, "scores", game.CurrentScore)
+		}
 
-		// Check for score changes
-		scoreChanged := false
-		for teamID, currentScore := range game.CurrentScore {
-			if lastScore, exists := lastScores[teamID]; !exists || lastScore != currentScore {
-				scoreChanged = true
-				break
+		// Check for score changes. Map order is random, so go through the teams in sorted order - anything built
+		// from them (like the changed teams in the log below) has to come out the same when the workflow is replayed.
+		scoreChanges := game.ScoreDiff(lastScores)
+		var changedTeamIDs []string
+		for _, teamID := range sortedTeamIDs(game.CurrentScore) {
+			if _, changed := scoreChanges[teamID]; changed {
+				changedTeamIDs = append(changedTeamIDs, teamID)
 			}
 		}
+		scoreChanged := len(changedTeamIDs) > 0
 
 		// Check for a new overtime
 		newOvertime := false
@@ -125,9 +324,18 @@ func GameWorkflow(ctx workflow.Context, game Game) (string, error) {
 		if scoreChanged  {
 
 			if slices.Contains(notificationTypes, "score_change") {
-				scoreUpdateNotification := buildScoreUpdateNotification(game)
-				notificationList = append(notificationList, scoreUpdateNotification)
-				logger.Info("Added score update notification", "gameID", game.ID)
+				if !focusTeamPlaying {
+					logger.Info("Skipped score update notification, no focus team in this game", "gameID", game.ID, "focusTeams", game.FocusTeams)
+				} else if notificationThrottled(game, workflow.Now(ctx)) {
+					logger.Info("Suppressed score update notification, last notification was too recent", "gameID", game.ID, "lastNotified", game.LastNotified, "minNotifyInterval", game.MinNotifyInterval)
+				} else if delta := scoreDelta(game.LastNotifiedScore, game.CurrentScore); game.MinScoreDelta > 0 && delta < game.MinScoreDelta {
+					logger.Info("Suppressed score update notification, score hasn't moved enough", "gameID", game.ID, "scoreDelta", delta, "minScoreDelta", game.MinScoreDelta)
+				} else {
+					scoreUpdateNotification := buildScoreUpdateNotification(game)
+					notificationList = append(notificationList, scoreUpdateNotification)
+					game.LastNotifiedScore = maps.Clone(game.CurrentScore)
+					logger.Info("Added score update notification", "gameID", game.ID)
+				}
 			}
 
 			if slices.Contains(notificationTypes, "underdog") {
@@ -163,20 +371,32 @@ func GameWorkflow(ctx workflow.Context, game Game) (string, error) {
 					}
 
 					// If the underdog was not previously winning but now is winning, send a notification (only send notification if underdog pulls ahead)
-					if !wasUnderdogWinning && game.UnderdogWinning {
-						underdogNotification := buildUnderdogNotification(game, underdogTeam)
-						notificationList = append(notificationList, underdogNotification)
-						logger.Info("Added underdog notification", "gameID", game.ID)
+					if !wasUnderdogWinning && game.UnderdogWinning && focusTeamPlaying {
+						currentPeriod, _ := strconv.Atoi(game.CurrentPeriod)
+						if underdogCoolingDown(game, currentPeriod) {
+							logger.Info("Suppressed underdog notification, already sent one recently", "gameID", game.ID, "period", currentPeriod, "lastUnderdogPeriod", game.LastUnderdogPeriod)
+						} else {
+							underdogNotification := buildUnderdogNotification(game, underdogTeam)
+							notificationList = append(notificationList, underdogNotification)
+							game.LastUnderdogPeriod = currentPeriod
+							logger.Info("Added underdog notification", "gameID", game.ID)
+						}
 					}
 				}
 			}
 
-			logger.Info("Score change detected", "gameID", game.ID)
+			if slices.Contains(notificationTypes, "comeback") {
+				comebackTeam, deficit := trackComeback(&game)
+				if comebackTeam != nil && focusTeamPlaying {
+					notificationList = append(notificationList, buildComebackNotification(game, *comebackTeam, deficit))
+					logger.Info("Added comeback notification", "gameID", game.ID, "team", comebackTeam.DisplayName, "deficit", deficit)
+				}
+			}
+
+			logger.Info("Score change detected", "gameID", game.ID, "changedTeamIDs", changedTeamIDs)
 
 			// Update last scores - maybe move this so it only updates if the notifications are sent successfully?
-			for teamID, score := range game.CurrentScore {
-				lastScores[teamID] = score
-			}
+			lastScores.CurrentScore = maps.Clone(game.CurrentScore)
 		}
 
 		// Send overtime notification if the game has gone into a new overtime period
@@ -192,33 +412,353 @@ func GameWorkflow(ctx workflow.Context, game Game) (string, error) {
 			}
 		}
 
-		// If there are notifications to send, send them
-		if len(notificationList) > 0 {
-			logger.Info("Notifications to send", "count", len(notificationList), "notifications", notificationList)
-			
-			// For each notification channel, send the collected list of notifications:
-			for channel := range notificationChannels {
-				sendNotifications := SendNotifications{
-					Channel: notificationChannels[channel],
-					NotificationList: notificationList,
+		// Send a heads-up once when the game gets into its final two minutes
+		if !finalMinutesNotified && slices.Contains(notificationTypes, "final_minutes") && inFinalMinutes(game) {
+			notificationList = append(notificationList, buildFinalMinutesNotification(game))
+			finalMinutesNotified = true
+			logger.Info("Added final minutes notification", "gameID", game.ID, "displayClock", game.DisplayClock)
+		}
+
+		// Warn once if the favorite is behind once the game is far enough along (the second half, by default)
+		if !game.FavoriteTrailingNotified && slices.Contains(notificationTypes, "favorite_trailing") && focusTeamPlaying {
+			if favorite := trailingFavorite(game); favorite != nil {
+				notificationList = append(notificationList, buildFavoriteTrailingNotification(game, *favorite))
+				game.FavoriteTrailingNotified = true
+				logger.Info("Added favorite trailing notification", "gameID", game.ID, "favorite", favorite.DisplayName, "period", game.CurrentPeriod)
+			}
+		}
+
+		// Send ESPN's description of any plays that scored since the last poll
+		if slices.Contains(notificationTypes, "scoring_play") && focusTeamPlaying {
+			var scoringPlays []ScoringPlay
+			err := workflow.ExecuteActivity(scoreCtx, GetScoringPlaysActivity, game).Get(ctx, &scoringPlays)
+			if err != nil {
+				logger.Error("Failed to fetch scoring plays", "gameID", game.ID, "error", err)
+			} else {
+				newPlays := newScoringPlays(scoringPlays, game.LastScoringPlayID)
+				if !scoringPlaysCaughtUp {
+					logger.Info("Skipping scoring plays from before monitoring started", "gameID", game.ID, "count", len(newPlays))
+					newPlays = nil
 				}
-		
-				err = workflow.ExecuteActivity(ctx, SendNotificationListActivity, sendNotifications).Get(ctx, nil)		
-				if err != nil {
-					logger.Error("Failed to send notification", "gameID", game.ID, "error", err)
+				for _, play := range newPlays {
+					notificationList = append(notificationList, buildScoringPlayNotification(game, play))
+					logger.Info("Added scoring play notification", "gameID", game.ID, "playID", play.ID)
+				}
+				if len(scoringPlays) > 0 {
+					game.LastScoringPlayID = scoringPlays[len(scoringPlays)-1].ID
+				}
+				scoringPlaysCaughtUp = true
+			}
+		}
+
+		// Send a win probability notification when the other team becomes the favorite to win
+		if slices.Contains(notificationTypes, "win_probability") && focusTeamPlaying {
+			var winProbability WinProbability
+			err := workflow.ExecuteActivity(scoreCtx, GetWinProbabilityActivity, game).Get(ctx, &winProbability)
+			var appErr *temporal.ApplicationError
+			if errors.As(err, &appErr) && appErr.Type() == NoWinProbabilityErrorType {
+				logger.Info("No win probability for game yet", "gameID", game.ID)
+			} else if err != nil {
+				logger.Error("Failed to fetch win probability", "gameID", game.ID, "error", err)
+			} else {
+				leader := winProbabilityLeader(winProbability, winProbabilityThreshold)
+				// The first reading just sets the baseline - only a change of favorite is worth a notification
+				if leader != "" && lastWinProbabilityLeader != "" && leader != lastWinProbabilityLeader {
+					notificationList = append(notificationList, buildWinProbabilityNotification(game, leader, winProbability))
+					logger.Info("Added win probability notification", "gameID", game.ID, "leader", leader, "homeWinPercentage", winProbability.HomeWinPercentage)
 				}
+				if leader != "" {
+					lastWinProbabilityLeader = leader
+				}
+			}
+		}
+
+		// The game's only over once it's stayed final for finalConfirmPolls polls in a row
+		if game.IsFinal() {
+			finalPolls++
+		} else {
+			if finalPolls > 0 {
+				logger.Info("Game is no longer final, still monitoring", "gameID", game.ID, "status", game.Status, "finalPolls", finalPolls)
+			}
+			finalPolls = 0
+		}
+		gameOver := finalPolls >= finalConfirmPolls
+		if gameOver && slices.Contains(notificationTypes, "final") {
+			notificationList = append(notificationList, buildFinalNotification(game))
+			logger.Info("Added final notification", "gameID", game.ID, "finalPolls", finalPolls)
+		}
+
+		// With batching on, new notifications wait one poll so anything from the next poll goes out with them
+		if game.BatchNotifications {
+			if len(notificationList) > 0 && len(pendingNotifications) == 0 && !gameOver {
+				pendingNotifications = notificationList
+				logger.Info("Holding notifications for the next poll", "gameID", game.ID, "count", len(notificationList))
+				continue
+			}
+			notificationList = append(pendingNotifications, notificationList...)
+			pendingNotifications = nil
+			if len(notificationList) > 1 {
+				notificationList = []Notification{combineNotifications(notificationList)}
 			}
 		}
+
+		// If there are notifications to send, send them
+		if len(notificationList) > 0 {
+			game.LastNotified = workflow.Now(ctx)
+			deliverNotifications(ctx, notifyCtx, &game, notificationChannels, notificationList)
+		}
+
+		if gameOver {
+			logger.Info("Game is final, stopping monitoring", "gameID", game.ID, "finalPolls", finalPolls)
+			break
+		}
+	}
+
+	// Don't drop anything still held for batching when monitoring ends
+	if len(pendingNotifications) > 0 {
+		deliverNotifications(ctx, notifyCtx, &game, notificationChannels, pendingNotifications)
+	}
+
+	result := GameResult{
+		GameID:    game.ID,
+		Sport:     game.Sport,
+		League:    game.League,
+		HomeTeam:  game.HomeTeam.DisplayName,
+		AwayTeam:  game.AwayTeam.DisplayName,
+		HomeScore: game.CurrentScore[game.HomeTeam.ID],
+		AwayScore: game.CurrentScore[game.AwayTeam.ID],
+		StartTime: game.StartTime,
+		MonitorStart: monitorStart,
+		MonitorEnd:   workflow.Now(ctx),
+	}
+
+	// Archive the result if the worker has somewhere to send it
+	if game.RecordResult {
+		err = workflow.ExecuteActivity(notifyCtx, RecordGameResultActivity, result).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to record game result", "gameID", game.ID, "error", err)
+		}
+	}
+
+	// Hand the result and everything held back over to the collection's digest
+	if game.DigestWorkflowID != "" {
+		entry := DigestEntry{Result: result, Notifications: game.NotificationHistory}
+		err = workflow.SignalExternalWorkflow(ctx, game.DigestWorkflowID, "", DigestGameResultSignal, entry).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to report game to digest", "gameID", game.ID, "digestWorkflowID", game.DigestWorkflowID, "error", err)
+		}
 	}
 
 	logger.Info("Game workflow completed", "gameID", game.ID)
-	var finalScore string = fmt.Sprintf("Final score: %s %s - %s %s", game.HomeTeam.Abbreviation, game.CurrentScore[game.HomeTeam.ID], game.AwayTeam.Abbreviation, game.CurrentScore[game.AwayTeam.ID])
+	var finalScore string = fmt.Sprintf("Final score: %s", scoreLine(game))
 	return finalScore, nil
 }
 
+// underdogCoolingDown reports whether an underdog alert went out too few periods ago. The underdog can trade the lead
+// back and forth, so by default it's once per period; a negative UnderdogCooldownPeriods turns the cooldown off.
+func underdogCoolingDown(game Game, currentPeriod int) bool {
+	cooldown := game.UnderdogCooldownPeriods
+	if cooldown == 0 {
+		cooldown = defaultUnderdogCooldownPeriods
+	}
+	if cooldown < 0 || game.LastUnderdogPeriod == 0 {
+		return false
+	}
+	return currentPeriod-game.LastUnderdogPeriod < cooldown
+}
+
+// notificationSettingsVersion is the GetVersion change ID for recording notification settings in history rather than
+// reading the config on every replay
+const notificationSettingsVersion = "recordNotificationSettings"
+
+// notificationSettings returns the notification types and channels for the game. StartGameWorkflowActivity puts them
+// on the game from the worker's config before it's started. A game started some other way records the config in a
+// SideEffect, so a worker restarted with different settings still replays it the same way - except games started by
+// a worker from before that, whose histories only replay if they read the config like they always did.
+func notificationSettings(ctx workflow.Context, game Game) (notificationTypes []string, notificationChannels []string) {
+	if len(game.NotificationTypes) > 0 && len(game.NotificationChannels) > 0 {
+		return game.NotificationTypes, game.NotificationChannels
+	}
+	if workflow.GetVersion(ctx, notificationSettingsVersion, workflow.DefaultVersion, 1) == workflow.DefaultVersion {
+		cfg := CurrentConfig()
+		return cfg.NotificationTypes, cfg.NotificationChannels
+	}
+
+	var settings [][]string
+	encoded := workflow.SideEffect(ctx, func(ctx workflow.Context) interface{} {
+		resolved := withNotificationSettings(game, CurrentConfig())
+		return [][]string{resolved.NotificationTypes, resolved.NotificationChannels}
+	})
+	if err := encoded.Get(&settings); err != nil || len(settings) != 2 {
+		workflow.GetLogger(ctx).Error("Failed to read notification settings", "gameID", game.ID, "error", err)
+		return nil, nil
+	}
+	return settings[0], settings[1]
+}
+
+// sendNotificationList sends the notifications to each channel, logging (not returning) failures so monitoring carries on,
+// and returns the channels they were delivered to. Channels SendNotificationListActivity doesn't know are skipped, so a
+// typo in NOTIFICATION_CHANNELS doesn't stop the rest from working. gameID is only for the logs, and is empty for
+// notifications that aren't about one game.
+func sendNotificationList(ctx workflow.Context, notifyCtx workflow.Context, gameID string, notificationChannels []string, notificationList []Notification) []string {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Notifications to send", "count", len(notificationList), "notifications", notificationList)
+
+	notificationChannels, unknownChannels := knownChannels(notificationChannels)
+	for _, channel := range unknownChannels {
+		logger.Warn("Skipping unknown notification channel", "gameID", gameID, "channel", channel)
+	}
+	if len(notificationChannels) == 0 {
+		logger.Error("No known notification channels to send to", "gameID", gameID, "unknownChannels", unknownChannels)
+		return nil
+	}
+
+	// For each notification channel, send the collected list of notifications:
+	var deliveredChannels, failedChannels []string
+	for _, channel := range notificationChannels {
+		sendNotifications := SendNotifications{
+			Channel:          channel,
+			NotificationList: notificationList,
+		}
+
+		err := workflow.ExecuteActivity(notifyCtx, SendNotificationListActivity, sendNotifications).Get(ctx, nil)
+		if err != nil {
+			logger.Error("Failed to send notification", "gameID", gameID, "channel", channel, "error", err)
+			failedChannels = append(failedChannels, channel)
+			continue
+		}
+		deliveredChannels = append(deliveredChannels, channel)
+	}
+
+	// One line per batch, so partial delivery is easy to spot without piecing together the per-channel errors
+	logger.Info("Notification delivery summary", "gameID", gameID, "notifications", len(notificationList),
+		"channels", len(notificationChannels), "succeeded", len(notificationChannels)-len(failedChannels),
+		"failed", len(failedChannels), "failedChannels", failedChannels)
+	return deliveredChannels
+}
+
+// deliverNotifications sends the notifications and adds them to the game's history. A digest game doesn't send
+// anything - they only go into the history, for its DigestWorkflow to summarize once the game's over.
+func deliverNotifications(ctx workflow.Context, notifyCtx workflow.Context, game *Game, notificationChannels []string, notificationList []Notification) {
+	sentAt := workflow.Now(ctx)
+	if game.DigestWorkflowID != "" {
+		workflow.GetLogger(ctx).Info("Holding notifications for the digest", "gameID", game.ID, "count", len(notificationList))
+		for _, notification := range notificationList {
+			game.NotificationHistory = append(game.NotificationHistory, SentNotification{
+				Title:   notification.Title,
+				Message: notification.Message,
+				SentAt:  sentAt,
+			})
+		}
+		return
+	}
+	delivered := sendNotificationList(ctx, notifyCtx, game.ID, notificationChannels, notificationList)
+	recordNotifications(game, notificationList, delivered, sentAt)
+}
+
+// recordNotifications adds notifications that were just sent to the game's history, unless no channel got them
+func recordNotifications(game *Game, notificationList []Notification, channels []string, sentAt time.Time) {
+	if len(channels) == 0 {
+		return
+	}
+	for _, notification := range notificationList {
+		game.NotificationHistory = append(game.NotificationHistory, SentNotification{
+			Title:    notification.Title,
+			Message:  notification.Message,
+			SentAt:   sentAt,
+			Channels: channels,
+		})
+	}
+}
+
+// combineNotifications merges batched notifications into one message, keeping the highest priority and the latest score card
+func combineNotifications(notificationList []Notification) Notification {
+	combined := Notification{
+		Title:    "Game Updates",
+		Priority: PriorityLow,
+	}
+	var parts []string
+	for _, notification := range notificationList {
+		parts = append(parts, notification.Title+"\n"+notification.Message)
+		if priorityRank(notification.Priority) > priorityRank(combined.Priority) {
+			combined.Priority = notification.Priority
+		}
+		if notification.ScoreCard != nil {
+			combined.ScoreCard = notification.ScoreCard
+		}
+	}
+	combined.Message = strings.Join(parts, "\n\n")
+	return combined
+}
+
+func priorityRank(priority string) int {
+	switch priority {
+	case PriorityHigh:
+		return 2
+	case PriorityNormal:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// focusTeamInGame reports whether one of the game's FocusTeams (IDs, abbreviations, or names) is playing.
+// No focus teams means every game counts.
+func focusTeamInGame(game Game) bool {
+	if len(game.FocusTeams) == 0 {
+		return true
+	}
+	for _, focusTeam := range game.FocusTeams {
+		focusTeam = strings.TrimSpace(focusTeam)
+		for _, team := range []Team{game.HomeTeam, game.AwayTeam} {
+			if focusTeam == team.ID || strings.EqualFold(focusTeam, team.Abbreviation) ||
+				strings.EqualFold(focusTeam, team.DisplayName) || strings.EqualFold(focusTeam, team.Location) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// validatePollInterval rejects setPollInterval updates below MinPollInterval before they reach the workflow
+func validatePollInterval(ctx workflow.Context, interval time.Duration) error {
+	if interval < MinPollInterval {
+		return fmt.Errorf("poll interval %s is below the minimum of %s", interval, MinPollInterval)
+	}
+	return nil
+}
+
+// gamePollInterval is how long to wait between score checks for this game
+func gamePollInterval(game Game) time.Duration {
+	if game.PollInterval > 0 {
+		return game.PollInterval
+	}
+	return pollInterval
+}
+
+// Non-critical notifications (score_change) are throttled to one per MinNotifyInterval. Underdog and overtime always go through.
+func notificationThrottled(game Game, now time.Time) bool {
+	if game.MinNotifyInterval <= 0 || game.LastNotified.IsZero() {
+		return false
+	}
+	return now.Sub(game.LastNotified) < game.MinNotifyInterval
+}
+
+// scoreDelta is the combined number of points scored between two score maps. Scores that aren't numbers count as 0.
+func scoreDelta(from map[string]string, to map[string]string) int {
+	delta := 0
+	for _, change := range (Game{CurrentScore: to}).ScoreDiff(Game{CurrentScore: from}) {
+		if change.Delta > 0 {
+			delta += change.Delta
+		} else {
+			delta -= change.Delta // score corrections count too
+		}
+	}
+	return delta
+}
+
 func buildScoreUpdateNotification(game Game) Notification {
 	notification := Notification{}
-	periodString := getPeriodStr(game.CurrentPeriod, game.Sport)
 
 	// Score update notification looks like this:
 		// Score Update!
@@ -226,38 +766,61 @@ func buildScoreUpdateNotification(game Game) Notification {
 		// Score: MICH 100 - OSU 0
 		// Q3, 12:34 left on ESPN
 	notification.Title = "Score Update!"
-	notification.Message = fmt.Sprintf("\n%s vs %s\nScore: %s %s - %s %s\n%s, %s left on %s", 
-		game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.HomeTeam.Abbreviation, game.CurrentScore[game.HomeTeam.ID], game.AwayTeam.Abbreviation, game.CurrentScore[game.AwayTeam.ID], periodString, game.DisplayClock, game.TVNetwork)
+	notification.Priority = PriorityNormal
+	notification.ScoreCard = newScoreCard(game)
+	notification.Message = fmt.Sprintf("\n%s vs %s\nScore: %s\n%s on %s", 
+		game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, scoreLine(game), gameStatusStr(game), game.TVNetwork)
 
+	notification.Message = withGameLink(notification.Message, game)
 	return notification
 }
 
 func buildUnderdogNotification(game Game, underdogTeam string) Notification {
-	periodString := getPeriodStr(game.CurrentPeriod, game.Sport)
 	notification := Notification{}
 	
 	// Underdog notification looks like this:
 		// Team Chaos!
-		// UCF Knights are winning in the UCF Knights vs. South Florida Bulls game on ESPN! It's currently Q2 with 10:15 left.
+		// UCF Knights are winning in the UCF Knights vs. South Florida Bulls game on ESPN! It's currently Q2, 10:15 left.
 		// Score: UCF 14 - USF 7
 	notification.Title = "Team Chaos!"
+	notification.Priority = PriorityHigh
+	notification.ScoreCard = newScoreCard(game)
 
-	notification.Message = fmt.Sprintf("%s are winning in the %s vs. %s game on %s! It's currently %s with %s left. \nScore: %s %s - %s %s", 
-		underdogTeam, game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, periodString, game.DisplayClock, game.HomeTeam.Abbreviation, game.CurrentScore[game.HomeTeam.ID], game.AwayTeam.Abbreviation, game.CurrentScore[game.AwayTeam.ID])
+	notification.Message = fmt.Sprintf("%s are winning in the %s vs. %s game on %s! It's currently %s. \nScore: %s", 
+		underdogTeam, game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, gameStatusStr(game), scoreLine(game))
 
+	notification.Message = withGameLink(notification.Message, game)
 	return notification
 }
 
 func buildOvertimeNotification(game Game) Notification {
-	notification := Notification{}
+	notification := Notification{Priority: PriorityHigh, ScoreCard: newScoreCard(game)}
 
 	currentPeriod, err := strconv.Atoi(game.CurrentPeriod)
 
 	if err != nil {
 		// If we can't parse the current period, just return a generic notification
 		notification.Title = "Overtime!"
-		notification.Message = fmt.Sprintf("The game between the %s and the %s is in overtime on %s!\nScore: %s %s - %s %s", 
-			game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, game.HomeTeam.Abbreviation, game.CurrentScore[game.HomeTeam.ID], game.AwayTeam.Abbreviation, game.CurrentScore[game.AwayTeam.ID])
+		notification.Message = fmt.Sprintf("The game between the %s and the %s is in overtime on %s!\nScore: %s", 
+			game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, scoreLine(game))
+		notification.Message = withGameLink(notification.Message, game)
+		return notification
+	}
+
+	// Baseball doesn't have overtime, it has extra innings
+	if game.Sport == "baseball" {
+		// Extra innings notification looks like this:
+			// Extra Innings!
+			// The game between the Detroit Tigers and the Cleveland Guardians is in extra innings (Top 10th) on ESPN!
+			// Score: DET 3 - CLE 3
+		inning := inningStr(game)
+		if inning == "" {
+			inning = getPeriodStr(game.CurrentPeriod, game.Sport, game.League)
+		}
+		notification.Title = "Extra Innings!"
+		notification.Message = fmt.Sprintf("The game between the %s and the %s is in extra innings (%s) on %s!\nScore: %s",
+			game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, inning, game.TVNetwork, scoreLine(game))
+		notification.Message = withGameLink(notification.Message, game)
 		return notification
 	}
 
@@ -281,16 +844,341 @@ func buildOvertimeNotification(game Game) Notification {
 		// Score: MICH 27 - OSU 27
 	notification.Title = fmt.Sprintf("%s!", overtimeStr)
 
-	notification.Message = fmt.Sprintf("The game between the %s and the %s is in %s on %s!\nScore: %s %s - %s %s", 
-		game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, overtimeStr, game.TVNetwork, game.HomeTeam.Abbreviation, game.CurrentScore[game.HomeTeam.ID], game.AwayTeam.Abbreviation, game.CurrentScore[game.AwayTeam.ID])
+	notification.Message = fmt.Sprintf("The game between the %s and the %s is in %s on %s!\nScore: %s", 
+		game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, overtimeStr, game.TVNetwork, scoreLine(game))
+
+	notification.Message = withGameLink(notification.Message, game)
+	return notification
+}
+
+// favoriteTrailingFromPeriod is the first period favorite_trailing alerts can fire in: FavoriteTrailingFromPeriod if it's
+// set, otherwise the start of the second half (period 3 of 4, 2 of 2, 5 of 9 innings)
+func favoriteTrailingFromPeriod(game Game) int {
+	if game.FavoriteTrailingFromPeriod > 0 {
+		return game.FavoriteTrailingFromPeriod
+	}
+	return game.NumberOfPeriods/2 + 1
+}
+
+// trailingFavorite returns the favorite if it's losing and the game is at or past favoriteTrailingFromPeriod
+func trailingFavorite(game Game) *Team {
+	currentPeriod, err := strconv.Atoi(game.CurrentPeriod)
+	if err != nil || currentPeriod < favoriteTrailingFromPeriod(game) {
+		return nil
+	}
+	homeScore, homeErr := strconv.Atoi(game.CurrentScore[game.HomeTeam.ID])
+	awayScore, awayErr := strconv.Atoi(game.CurrentScore[game.AwayTeam.ID])
+	if homeErr != nil || awayErr != nil {
+		return nil
+	}
+	switch {
+	case game.HomeTeam.Favorite && homeScore < awayScore:
+		return &game.HomeTeam
+	case game.AwayTeam.Favorite && awayScore < homeScore:
+		return &game.AwayTeam
+	}
+	return nil
+}
+
+func buildFavoriteTrailingNotification(game Game, favorite Team) Notification {
+	// Favorite trailing notification looks like this:
+		// Upset Alert!
+		// Ohio State Buckeyes are favored but trailing in the Michigan Wolverines vs. Ohio State Buckeyes game on FOX! It's currently Q3, 10:15 left.
+		// Score: MICH 17 - OSU 10
+	notification := Notification{Title: "Upset Alert!", Priority: PriorityHigh, ScoreCard: newScoreCard(game)}
+	notification.Message = fmt.Sprintf("%s are favored but trailing in the %s vs. %s game on %s! It's currently %s.\nScore: %s",
+		favorite.DisplayName, game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, gameStatusStr(game), scoreLine(game))
+
+	notification.Message = withGameLink(notification.Message, game)
+	return notification
+}
+
+// trackComeback records each team's biggest deficit on the game (so it carries over with the workflow input), and returns
+// the team that just took the lead after trailing by ComebackMargin or more, with that deficit. A team's deficit resets
+// once it's had its comeback alert, so it takes another big hole to fire again.
+func trackComeback(game *Game) (*Team, int) {
+	margin := game.ComebackMargin
+	if margin <= 0 {
+		margin = defaultComebackMargin
+	}
+	if game.MaxDeficit == nil {
+		game.MaxDeficit = make(map[string]int)
+	}
+
+	homeScore, homeErr := strconv.Atoi(game.CurrentScore[game.HomeTeam.ID])
+	awayScore, awayErr := strconv.Atoi(game.CurrentScore[game.AwayTeam.ID])
+	if homeErr != nil || awayErr != nil {
+		return nil, 0
+	}
+
+	var comebackTeam *Team
+	deficit := 0
+	teams := []struct {
+		team         Team
+		score, other int
+	}{
+		{game.HomeTeam, homeScore, awayScore},
+		{game.AwayTeam, awayScore, homeScore},
+	}
+	for _, t := range teams {
+		if t.score < t.other {
+			game.MaxDeficit[t.team.ID] = max(game.MaxDeficit[t.team.ID], t.other-t.score)
+		} else if t.score > t.other && game.MaxDeficit[t.team.ID] >= margin {
+			team := t.team
+			comebackTeam, deficit = &team, game.MaxDeficit[t.team.ID]
+			game.MaxDeficit[t.team.ID] = 0
+		}
+	}
+	return comebackTeam, deficit
+}
+
+func buildComebackNotification(game Game, team Team, deficit int) Notification {
+	// Comeback notification looks like this:
+		// Comeback!
+		// The Ohio State Buckeyes were down by 17 and now lead the Michigan Wolverines vs. Ohio State Buckeyes game on FOX!
+		// Score: MICH 17 - OSU 21
+	notification := Notification{Title: "Comeback!", Priority: PriorityHigh, ScoreCard: newScoreCard(game)}
+	notification.Message = fmt.Sprintf("The %s were down by %d and now lead the %s vs. %s game on %s!\nScore: %s",
+		team.DisplayName, deficit, game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, scoreLine(game))
+
+	notification.Message = withGameLink(notification.Message, game)
+	return notification
+}
+
+// inFinalMinutes reports whether the game is in the last period of regulation with under two minutes on the clock.
+// A clock we can't read (soccer's running clock, halftime) never counts, and neither does 0:00 - that's the end, not the final minutes.
+func inFinalMinutes(game Game) bool {
+	currentPeriod, err := strconv.Atoi(game.CurrentPeriod)
+	if err != nil || game.NumberOfPeriods == 0 || currentPeriod != game.NumberOfPeriods {
+		return false
+	}
+	clock, err := parseClock(game.DisplayClock)
+	if err != nil {
+		return false
+	}
+	return clock > 0 && clock < finalMinutes
+}
+
+func buildFinalMinutesNotification(game Game) Notification {
+	// Final minutes notification looks like this:
+		// Final Minutes!
+		// Under two minutes left in the Michigan Wolverines vs. Ohio State Buckeyes game on FOX!
+		// Score: MICH 24 - OSU 21
+	notification := Notification{Title: "Final Minutes!", Priority: PriorityHigh, ScoreCard: newScoreCard(game)}
+	notification.Message = fmt.Sprintf("Under two minutes left in the %s vs. %s game on %s!\nScore: %s",
+		game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, scoreLine(game))
+
+	notification.Message = withGameLink(notification.Message, game)
+	return notification
+}
+
+func buildReminderNotification(game Game, untilStart time.Duration, location *time.Location) Notification {
+	// Reminder notification looks like this:
+		// Starting Soon
+		// Michigan Wolverines vs. Ohio State Buckeyes starts in 15 minutes (12:00 PM EST) on FOX
+	notification := Notification{Title: "Starting Soon", Priority: PriorityLow}
+	notification.Message = fmt.Sprintf("%s vs. %s starts in %s (%s)",
+		game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, untilStr(untilStart), game.StartTime.In(location).Format("3:04 PM MST"))
+	if game.TVNetwork != "" {
+		notification.Message += " on " + game.TVNetwork
+	}
+
+	notification.Message = withGameLink(notification.Message, game)
+	return notification
+}
+
+// untilStr describes a wait to the minute, e.g. "1 hour 5 minutes"
+func untilStr(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d < time.Minute {
+		return "less than a minute"
+	}
+	plural := func(n int, unit string) string {
+		if n == 1 {
+			return fmt.Sprintf("1 %s", unit)
+		}
+		return fmt.Sprintf("%d %ss", n, unit)
+	}
+	hours, minutes := int(d/time.Hour), int(d%time.Hour/time.Minute)
+	switch {
+	case hours == 0:
+		return plural(minutes, "minute")
+	case minutes == 0:
+		return plural(hours, "hour")
+	default:
+		return plural(hours, "hour") + " " + plural(minutes, "minute")
+	}
+}
+
+func buildFinalNotification(game Game) Notification {
+	// Final notification looks like this:
+		// Final!
+		// Michigan Wolverines vs. Ohio State Buckeyes
+		// Final score: MICH 30 - OSU 24
+	notification := Notification{Title: "Final!", Priority: PriorityHigh, ScoreCard: newScoreCard(game)}
+	notification.Message = fmt.Sprintf("%s vs. %s\nFinal score: %s",
+		game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, scoreLine(game))
+
+	notification.Message = withGameLink(notification.Message, game)
+	return notification
+}
+
+// winProbabilityLeader returns "home" or "away" for the team whose chance of winning is over the threshold, or "" if neither is
+func winProbabilityLeader(winProbability WinProbability, threshold float64) string {
+	if winProbability.HomeWinPercentage > threshold {
+		return "home"
+	}
+	if winProbability.AwayWinPercentage() > threshold {
+		return "away"
+	}
+	return ""
+}
+
+func buildWinProbabilityNotification(game Game, leader string, winProbability WinProbability) Notification {
+	team := game.HomeTeam
+	percentage := winProbability.HomeWinPercentage
+	if leader == "away" {
+		team = game.AwayTeam
+		percentage = winProbability.AwayWinPercentage()
+	}
+
+	// Win probability notification looks like this:
+		// Momentum Swing!
+		// Ohio State Buckeyes now have a 62% chance to win the Michigan Wolverines vs. Ohio State Buckeyes game on FOX.
+		// Score: MICH 14 - OSU 17
+	notification := Notification{Title: "Momentum Swing!", Priority: PriorityNormal, ScoreCard: newScoreCard(game)}
+	notification.Message = fmt.Sprintf("%s now have a %.0f%% chance to win the %s vs. %s game on %s.\nScore: %s",
+		team.DisplayName, percentage*100, game.HomeTeam.DisplayName, game.AwayTeam.DisplayName, game.TVNetwork, scoreLine(game))
+
+	notification.Message = withGameLink(notification.Message, game)
+	return notification
+}
+
+// newScoringPlays returns the plays listed after lastPlayID (plays are oldest first). If lastPlayID isn't there -
+// nothing seen yet - every play is new.
+func newScoringPlays(plays []ScoringPlay, lastPlayID string) []ScoringPlay {
+	for i, play := range plays {
+		if play.ID == lastPlayID {
+			return plays[i+1:]
+		}
+	}
+	return plays
+}
+
+func buildScoringPlayNotification(game Game, play ScoringPlay) Notification {
+	// Scoring play notification looks like this:
+		// Touchdown!
+		// Blake Corum 12 Yd Run (James Turner Kick)
+		// Score: MICH 14 - OSU 10
+	title := "Score!"
+	if play.Type.Text != "" {
+		title = play.Type.Text + "!"
+	}
+	notification := Notification{Title: title, Priority: PriorityNormal, ScoreCard: newScoreCard(game)}
+	notification.Message = fmt.Sprintf("%s\nScore: %s", play.Text, scoreLine(game))
 
+	notification.Message = withGameLink(notification.Message, game)
 	return notification
 }
 
-func getPeriodStr(period string, sport string) string {
+// withGameLink adds the ESPN game page to the end of a notification message, so people can click through
+func withGameLink(message string, game Game) string {
+	if game.GameURL == "" {
+		return message
+	}
+	return message + "\n" + game.GameURL
+}
+
+// gameStatusStr describes where the game is, e.g. "Q3, 12:34 left". ESPN's own status line wins when we have one.
+func gameStatusStr(game Game) string {
+	if game.Sport == "baseball" {
+		// No clock in baseball - "Top 7th" if we know the half, otherwise just the inning
+		if inning := inningStr(game); inning != "" {
+			return inning
+		}
+		if game.StatusDetail != "" {
+			return game.StatusDetail
+		}
+		return getPeriodStr(game.CurrentPeriod, game.Sport, game.League)
+	}
+	if game.StatusDetail != "" {
+		return game.StatusDetail
+	}
+	return fmt.Sprintf("%s, %s left", getPeriodStr(game.CurrentPeriod, game.Sport, game.League), game.DisplayClock)
+}
+
+// missingScore stands in for a score we don't have, rather than leaving a gap like "MICH  - OSU "
+const missingScore = "—"
+
+// scoreLine formats the score for messages, e.g. "MICH 13 - OSU 10"
+func scoreLine(game Game) string {
+	return fmt.Sprintf("%s %s - %s %s", game.HomeTeam.Abbreviation, teamScore(game, game.HomeTeam.ID), game.AwayTeam.Abbreviation, teamScore(game, game.AwayTeam.ID))
+}
+
+// teamScore is a team's score, or missingScore if CurrentScore doesn't have it
+func teamScore(game Game, teamID string) string {
+	if score := game.CurrentScore[teamID]; score != "" {
+		return score
+	}
+	return missingScore
+}
+
+// sortedTeamIDs returns the team IDs in a score map in sorted order, for iterating it deterministically in a workflow
+func sortedTeamIDs(scores map[string]string) []string {
+	teamIDs := make([]string, 0, len(scores))
+	for teamID := range scores {
+		teamIDs = append(teamIDs, teamID)
+	}
+	sort.Strings(teamIDs)
+	return teamIDs
+}
+
+// missingScoreTeams returns the IDs of the home/away teams CurrentScore has no score for. That shouldn't happen unless
+// ESPN's team IDs stop matching the ones the game was built with.
+func missingScoreTeams(game Game) []string {
+	var missing []string
+	for _, teamID := range []string{game.HomeTeam.ID, game.AwayTeam.ID} {
+		if game.CurrentScore[teamID] == "" {
+			missing = append(missing, teamID)
+		}
+	}
+	return missing
+}
+
+// newScoreCard pulls the pieces of a game notification out for channels that lay them out themselves (e.g. Slack blocks)
+func newScoreCard(game Game) *ScoreCard {
+	return &ScoreCard{
+		HomeTeam:  game.HomeTeam.DisplayName,
+		AwayTeam:  game.AwayTeam.DisplayName,
+		Score:     scoreLine(game),
+		Period:    gameStatusStr(game),
+		TVNetwork: game.TVNetwork,
+		GameURL:   game.GameURL,
+	}
+}
+
+func getPeriodStr(period string, sport string, league string) string {
 	switch sport {
 	case "baseball":
+		if periodNumber, err := strconv.Atoi(period); err == nil && periodNumber > 0 {
+			return fmt.Sprintf("%s Inning", ordinal(periodNumber))
+		}
 		return fmt.Sprintf("Inning %s", period)
+	case "basketball":
+		// Men's college basketball plays two halves - the NBA, WNBA and women's college play quarters
+		if regulationPeriods(sport, league) == 2 {
+			switch period {
+			case "1":
+				return "1st Half"
+			case "2":
+				return "2nd Half"
+			case "3":
+				return "OT"
+			}
+			if periodNumber, err := strconv.Atoi(period); err == nil && periodNumber > 3 {
+				return fmt.Sprintf("%dOT", periodNumber-2)
+			}
+		}
 	case "hockey":
 		switch period {
 		case "1":
@@ -306,6 +1194,67 @@ func getPeriodStr(period string, sport string) string {
 	return fmt.Sprintf("Q%s", period) // default to quarters for other sports
 }
 
+// inningHalves maps the start of ESPN's baseball status line (detail or shortDetail) to the half of the inning
+var inningHalves = map[string]string{
+	"top":    "Top",
+	"bottom": "Bottom",
+	"bot":    "Bottom",
+	"middle": "Middle",
+	"mid":    "Middle",
+	"end":    "End",
+}
+
+// inningHalf reads the half of the inning from ESPN's status line, e.g. "Bottom" from "Bot 7th". Empty if it isn't there.
+func inningHalf(detail string) string {
+	first, _, _ := strings.Cut(strings.TrimSpace(detail), " ")
+	return inningHalves[strings.ToLower(first)]
+}
+
+// inningStr renders a baseball game's inning with its half, e.g. "Top 7th" or "Bottom 10th". Empty if the half or
+// inning isn't known.
+func inningStr(game Game) string {
+	half := inningHalf(game.StatusDetail)
+	inning, err := strconv.Atoi(game.CurrentPeriod)
+	if half == "" || err != nil || inning <= 0 {
+		return ""
+	}
+	return fmt.Sprintf("%s %s", half, ordinal(inning))
+}
+
+// ordinal renders 1, 2, 3, 11 as "1st", "2nd", "3rd", "11th"
+func ordinal(n int) string {
+	suffix := "th"
+	switch n % 10 {
+	case 1:
+		suffix = "st"
+	case 2:
+		suffix = "nd"
+	case 3:
+		suffix = "rd"
+	}
+	if n%100 >= 11 && n%100 <= 13 {
+		suffix = "th"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
+
+// regulationPeriods is how many periods a game has before overtime, for when ESPN doesn't tell us
+func regulationPeriods(sport string, league string) int {
+	switch sport {
+	case "baseball":
+		return 9
+	case "hockey":
+		return 3
+	case "soccer":
+		return 2
+	case "basketball":
+		if league == "mens-college-basketball" {
+			return 2
+		}
+	}
+	return 4
+}
+
 func determineUnderdog(game Game) (string) {
 	if game.HomeTeam.Underdog {
 		return game.HomeTeam.DisplayName
@@ -1,11 +1,20 @@
 package sports
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"strconv"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/log"
+	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/testsuite"
 )
 
@@ -150,6 +159,122 @@ func TestGameWorkflow_FutureGame(t *testing.T) {
 	assert.NoError(t, env.GetWorkflowError())
 }
 
+func TestGameWorkflow_StartImmediately(t *testing.T) {
+	tests := []struct {
+		name              string
+		startImmediately  bool
+		expectedFirstPoll time.Duration // after the workflow starts, give or take the poll jitter
+	}{
+		{name: "waits for start time by default", startImmediately: false, expectedFirstPoll: 2*time.Hour + pollInterval},
+		{name: "polls right away", startImmediately: true, expectedFirstPoll: pollInterval},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			workflowStart := time.Date(2024, 11, 30, 15, 0, 0, 0, time.UTC)
+			env.SetStartTime(workflowStart)
+
+			// Stop after the first poll
+			var firstPoll time.Time
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+				firstPoll = env.Now()
+				return Game{}, temporal.NewNonRetryableApplicationError("game not found", GameNotFoundErrorType, nil)
+			})
+
+			game := Game{
+				ID:               "test-game-start-immediately",
+				StartTime:        workflowStart.Add(2 * time.Hour),
+				Status:           "pre",
+				StartImmediately: tt.startImmediately,
+				CurrentScore:     map[string]string{"130": "0", "194": "0"},
+				HomeTeam:         Team{ID: "130", DisplayName: "Michigan Wolverines"},
+				AwayTeam:         Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			require.False(t, firstPoll.IsZero())
+
+			elapsed := firstPoll.Sub(workflowStart)
+			assert.GreaterOrEqual(t, elapsed, tt.expectedFirstPoll)
+			assert.Less(t, elapsed, tt.expectedFirstPoll+maxPollJitter)
+		})
+	}
+}
+
+// updateCallbacks records the outcome of an Update sent with env.UpdateWorkflow
+type updateCallbacks struct {
+	accepted bool
+	rejected error
+	result   interface{}
+	err      error
+}
+
+func (c *updateCallbacks) Accept()          { c.accepted = true }
+func (c *updateCallbacks) Reject(err error) { c.rejected = err }
+func (c *updateCallbacks) Complete(success interface{}, err error) {
+	c.result = success
+	c.err = err
+}
+
+func TestGameWorkflow_SetPollIntervalUpdate(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	var polls []time.Time
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		polls = append(polls, env.Now())
+		return Game{CurrentScore: map[string]string{"130": "0", "194": "0"}}, nil
+	})
+
+	// Partway through the first wait, ask for a check every 10 seconds (too short), then every minute
+	tooShort := &updateCallbacks{}
+	oneMinute := &updateCallbacks{}
+	env.RegisterDelayedCallback(func() {
+		env.UpdateWorkflow(SetPollIntervalUpdate, "too-short", tooShort, 10*time.Second)
+		env.UpdateWorkflow(SetPollIntervalUpdate, "one-minute", oneMinute, time.Minute)
+	}, 2*time.Minute)
+
+	// Already underway, with 10 minutes of monitoring left
+	game := Game{
+		ID:           "test-game-poll-interval",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(10 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// The validator turns down 10s before it reaches the workflow
+	assert.False(t, tooShort.accepted)
+	assert.ErrorContains(t, tooShort.rejected, "below the minimum")
+
+	// 1m is accepted and the new interval comes back
+	assert.True(t, oneMinute.accepted)
+	assert.NoError(t, oneMinute.rejected)
+	assert.NoError(t, oneMinute.err)
+	assert.Equal(t, time.Minute, oneMinute.result)
+
+	// The first wait is cut short to a minute, rather than running out the 5 minutes plus jitter
+	require.NotEmpty(t, polls)
+	assert.Equal(t, 2*time.Minute, polls[0].Sub(workflowStart))
+	for i := 1; i < len(polls); i++ {
+		assert.Equal(t, time.Minute, polls[i].Sub(polls[i-1]))
+	}
+	assert.Len(t, polls, 9)
+}
+
 func TestGameWorkflow_QueryHandler(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -214,6 +339,42 @@ func TestGameWorkflow_ActivityFailure(t *testing.T) {
 	assert.NoError(t, env.GetWorkflowError())
 }
 
+func TestGameWorkflow_GameNotFound(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// The game has dropped off the scoreboard
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		polls++
+		return Game{}, temporal.NewNonRetryableApplicationError("game not found: "+game.ID, GameNotFoundErrorType, nil)
+	})
+
+	game := Game{
+		ID:           "test-game-gone",
+		StartTime:    workflowStart.Add(-time.Hour),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "21", "264": "14"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:     Team{ID: "264", DisplayName: "Washington Huskies", Abbreviation: "WASH"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// Monitoring stops on the first not-found instead of polling for the remaining 4 hours
+	assert.Equal(t, 1, polls)
+	assert.Less(t, env.Now().Sub(workflowStart), 10*time.Minute)
+
+	var result string
+	require.NoError(t, env.GetWorkflowResult(&result))
+	assert.Equal(t, "Final score: MICH 21 - WASH 14", result)
+}
+
 func TestGameWorkflow_LongRunning(t *testing.T) {
 	testSuite := &testsuite.WorkflowTestSuite{}
 	env := testSuite.NewTestWorkflowEnvironment()
@@ -300,29 +461,1955 @@ func TestGameWorkflow_NoScoreChange(t *testing.T) {
 	env.AssertExpectations(t)
 }
 
-// Benchmark test for workflow execution
-func BenchmarkGameWorkflow(b *testing.B) {
+func TestGameWorkflow_NotificationThrottle(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
 	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// The home team scores on every poll
+	homeScore := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		homeScore += 7
+		return Game{
+			CurrentPeriod: "2",
+			CurrentScore:  map[string]string{"130": strconv.Itoa(homeScore), "194": "0"},
+		}, nil
+	})
+
+	sends := 0
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sends++
+		return nil
+	})
 
+	// Leave 30 minutes of monitoring, so we get polls at 5, 10, 15, 20, 25 and 30 minutes
 	game := Game{
-		ID:        "benchmark-game",
-		StartTime: time.Now().Add(-time.Hour),
-		Status:    "in",
-		HomeTeam: Team{
-			ID:          "130",
-			DisplayName: "Michigan Wolverines",
-		},
-		AwayTeam: Team{
-			ID:          "264",
-			DisplayName: "Washington Huskies",
+		ID:                "test-game-throttle",
+		StartTime:         workflowStart.Add(-5 * time.Hour).Add(30 * time.Minute),
+		Status:            "in",
+		MinNotifyInterval: 10 * time.Minute,
+		CurrentScore: map[string]string{
+			"130": "0",
+			"194": "0",
 		},
+		HomeTeam: Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam: Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
 	}
 
-	b.ResetTimer()
-	for i := 0; i < b.N; i++ {
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// Six score changes, but only the ones at least 10 minutes apart (5, 15, 25) are sent
+	assert.Equal(t, 6, homeScore/7)
+	assert.Equal(t, 3, sends)
+}
+
+// recordingLogger keeps the keyvals of every Info or Warn line with a given message
+type recordingLogger struct {
+	log.Logger
+	message string
+	lines   [][]interface{}
+}
+
+func (l *recordingLogger) Info(msg string, keyvals ...interface{}) {
+	if msg == l.message {
+		l.lines = append(l.lines, keyvals)
+	}
+	l.Logger.Info(msg, keyvals...)
+}
+
+func (l *recordingLogger) Warn(msg string, keyvals ...interface{}) {
+	if msg == l.message {
+		l.lines = append(l.lines, keyvals)
+	}
+	l.Logger.Warn(msg, keyvals...)
+}
+
+// keyval returns the value logged for key
+func keyval(keyvals []interface{}, key string) interface{} {
+	for i := 0; i+1 < len(keyvals); i += 2 {
+		if keyvals[i] == key {
+			return keyvals[i+1]
+		}
+	}
+	return nil
+}
+
+func TestGameWorkflow_UnknownNotificationChannel(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "slack,bogus")
+
+	logger := &recordingLogger{Logger: log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), message: "Skipping unknown notification channel"}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(logger)
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+		CurrentPeriod: "2",
+		CurrentScore:  map[string]string{"130": "7", "194": "0"},
+	}, nil)
+
+	var channels []string
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		channels = append(channels, sendNotifications.Channel)
+		return nil
+	})
+
+	// One poll, which finds a touchdown
+	game := Game{
+		ID:           "test-game-bogus-channel",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(5 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// Slack still gets the score, and the typo is only warned about
+	assert.Equal(t, []string{"slack"}, channels)
+	require.Len(t, logger.lines, 1)
+	assert.Equal(t, "bogus", keyval(logger.lines[0], "channel"))
+	assert.Equal(t, "test-game-bogus-channel", keyval(logger.lines[0], "gameID"))
+}
+
+func TestGameWorkflow_NotificationSettings(t *testing.T) {
+	// What the worker is configured with now - only used for games that didn't come with settings
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "slack")
+
+	tests := []struct {
+		name             string
+		types            []string
+		channels         []string
+		expectedChannels []string
+	}{
+		{name: "settings on the game win", types: []string{"score_change"}, channels: []string{"logger", "ntfy"}, expectedChannels: []string{"logger", "ntfy"}},
+		{name: "recorded from the config otherwise", expectedChannels: []string{"slack"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+			env.SetStartTime(workflowStart)
+
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+				CurrentPeriod: "2",
+				CurrentScore:  map[string]string{"130": "7", "194": "0"},
+			}, nil)
+
+			var channels []string
+			env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+				channels = append(channels, sendNotifications.Channel)
+				return nil
+			})
+
+			// One poll, which finds a touchdown
+			game := Game{
+				ID:                   "test-game-settings",
+				StartTime:            workflowStart.Add(-5 * time.Hour).Add(5 * time.Minute),
+				Status:               "in",
+				CurrentScore:         map[string]string{"130": "0", "194": "0"},
+				HomeTeam:             Team{ID: "130", DisplayName: "Michigan Wolverines"},
+				AwayTeam:             Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+				NotificationTypes:    tt.types,
+				NotificationChannels: tt.channels,
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			assert.Equal(t, tt.expectedChannels, channels)
+
+			// Kept on the game, so they carry over with it
+			encoded, err := env.QueryWorkflow("gameInfo")
+			require.NoError(t, err)
+			var gameInfo Game
+			require.NoError(t, encoded.Get(&gameInfo))
+			assert.Equal(t, []string{"score_change"}, gameInfo.NotificationTypes)
+			assert.Equal(t, tt.expectedChannels, gameInfo.NotificationChannels)
+		})
+	}
+}
+
+func TestGameWorkflow_NotificationDeliverySummary(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger,slack")
+
+	logger := &recordingLogger{Logger: log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), message: "Notification delivery summary"}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(logger)
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// The home team scores on the first two polls only
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		polls++
+		homeScore := min(polls, 2) * 7
+		return Game{
+			CurrentPeriod: "2",
+			CurrentScore:  map[string]string{"130": strconv.Itoa(homeScore), "194": "0"},
+		}, nil
+	})
+
+	// Slack is down
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		if sendNotifications.Channel == "slack" {
+			return temporal.NewNonRetryableApplicationError("slack is down", "SlackError", nil)
+		}
+		return nil
+	})
+
+	// Leave 20 minutes of monitoring, so we get polls at 5, 10, 15 and 20 minutes
+	game := Game{
+		ID:           "test-game-summary",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(20 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+
+	// Four polls, but only the two with a score change sent anything
+	assert.Equal(t, 4, polls)
+	require.Len(t, logger.lines, 2)
+	for _, line := range logger.lines {
+		assert.Equal(t, "test-game-summary", keyval(line, "gameID"))
+		assert.Equal(t, 1, keyval(line, "notifications"))
+		assert.Equal(t, 2, keyval(line, "channels"))
+		assert.Equal(t, 1, keyval(line, "succeeded"))
+		assert.Equal(t, 1, keyval(line, "failed"))
+		assert.Equal(t, []string{"slack"}, keyval(line, "failedChannels"))
+	}
+}
+
+func TestGameWorkflow_MinScoreDelta(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2025, 3, 20, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// A free throw, then a three, then another free throw
+	scores := []map[string]string{
+		{"130": "41", "194": "40"},
+		{"130": "41", "194": "43"},
+		{"130": "42", "194": "43"},
+	}
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		score := scores[min(polls, len(scores)-1)]
+		polls++
+		return Game{CurrentPeriod: "2", CurrentScore: score}, nil
+	})
+
+	var sent []Notification
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		return nil
+	})
+
+	// Leave 15 minutes of monitoring, so we get three polls
+	game := Game{
+		ID:            "test-game-min-score-delta",
+		Sport:         "basketball",
+		League:        "mens-college-basketball",
+		StartTime:     workflowStart.Add(-5 * time.Hour).Add(15 * time.Minute),
+		Status:        "in",
+		MinScoreDelta: 3,
+		CurrentScore:  map[string]string{"130": "40", "194": "40"},
+		HomeTeam:      Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:      Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, 3, polls)
+
+	// The free throw is held back, the three takes it to 4 points since the start and fires,
+	// and the last free throw is only 1 point since that alert
+	require.Len(t, sent, 1)
+	assert.Contains(t, sent[0].Message, "MICH 41 - OSU 43")
+}
+
+func TestGameWorkflow_FinalGracePeriod(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "final")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 20, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// ESPN calls it final, takes it back for a review, then calls it final for good
+	statuses := []string{"post", "in", "post", "post", "post"}
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+		return Game{CurrentPeriod: "4", Status: status, CurrentScore: map[string]string{"130": "30", "194": "24"}}, nil
+	})
+
+	var sent []Notification
+	var sentAtPoll []int
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		sentAtPoll = append(sentAtPoll, polls)
+		return nil
+	})
+
+	game := Game{
+		ID:           "test-game-final",
+		Sport:        "football",
+		League:       "college-football",
+		StartTime:    workflowStart.Add(-3 * time.Hour),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "30", "194": "24"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// The first final doesn't count, and monitoring stops once the second final is confirmed
+	assert.Equal(t, 4, polls)
+	require.Len(t, sent, 1)
+	assert.Equal(t, []int{4}, sentAtPoll)
+	assert.Equal(t, "Final!", sent[0].Title)
+	assert.Equal(t, PriorityHigh, sent[0].Priority)
+	assert.Contains(t, sent[0].Message, "Final score: MICH 30 - OSU 24")
+}
+
+func TestGameWorkflow_Reminder(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 15, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+	gameStart := workflowStart.Add(2 * time.Hour) // noon in Ann Arbor
+
+	// The first poll finds the opening touchdown
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		return Game{CurrentPeriod: "1", CurrentScore: map[string]string{"130": "7", "194": "0"}}, nil
+	})
+
+	var sent []Notification
+	var sentAt []time.Time
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		sentAt = append(sentAt, env.Now())
+		return nil
+	})
+
+	game := Game{
+		ID:           "test-game-reminder",
+		Sport:        "football",
+		League:       "college-football",
+		StartTime:    gameStart,
+		Status:       "pre",
+		TVNetwork:    "FOX",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+		ReminderLead: 15 * time.Minute,
+		Timezone:     "America/New_York",
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// The reminder goes out 15 minutes ahead, before anything from the game itself
+	require.GreaterOrEqual(t, len(sent), 2)
+	assert.Equal(t, "Starting Soon", sent[0].Title)
+	assert.Equal(t, "Michigan Wolverines vs. Ohio State Buckeyes starts in 15 minutes (12:00 PM EST) on FOX", sent[0].Message)
+	assert.True(t, gameStart.Add(-15*time.Minute).Equal(sentAt[0]))
+	assert.Equal(t, "Score Update!", sent[1].Title)
+	assert.True(t, sentAt[1].After(gameStart))
+}
+
+func TestGameWorkflow_ReminderUsesGameChannels(t *testing.T) {
+	// The worker's config has changed since the game was scheduled
+	t.Setenv("NOTIFICATION_CHANNELS", "slack")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 15, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{CurrentPeriod: "1", CurrentScore: map[string]string{"130": "0", "194": "0"}}, nil)
+
+	var reminderChannels []string
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		if sendNotifications.NotificationList[0].Title == "Starting Soon" {
+			reminderChannels = append(reminderChannels, sendNotifications.Channel)
+		}
+		return nil
+	})
+
+	env.ExecuteWorkflow(GameWorkflow, Game{
+		ID:                   "test-game-reminder-channels",
+		StartTime:            workflowStart.Add(time.Hour),
+		Status:               "pre",
+		CurrentScore:         map[string]string{"130": "0", "194": "0"},
+		HomeTeam:             Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:             Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+		ReminderLead:         15 * time.Minute,
+		NotificationTypes:    []string{"score_change"},
+		NotificationChannels: []string{"logger", "ntfy"},
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, []string{"logger", "ntfy"}, reminderChannels)
+}
+
+func TestBuildReminderNotification(t *testing.T) {
+	game := Game{
+		StartTime: time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC),
+		HomeTeam:  Team{DisplayName: "Michigan Wolverines"},
+		AwayTeam:  Team{DisplayName: "Ohio State Buckeyes"},
+	}
+	pacific, err := time.LoadLocation("America/Los_Angeles")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		untilStart time.Duration
+		location   *time.Location
+		expected   string
+	}{
+		{"minutes", 15 * time.Minute, time.UTC, "starts in 15 minutes (5:00 PM UTC)"},
+		{"hours and minutes", 90 * time.Minute, pacific, "starts in 1 hour 30 minutes (9:00 AM PST)"},
+		{"whole hours", 2 * time.Hour, time.UTC, "starts in 2 hours (5:00 PM UTC)"},
+		{"one minute", 70 * time.Second, time.UTC, "starts in 1 minute (5:00 PM UTC)"},
+		{"under a minute", 20 * time.Second, time.UTC, "starts in less than a minute (5:00 PM UTC)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			notification := buildReminderNotification(game, tt.untilStart, tt.location)
+			assert.Equal(t, "Michigan Wolverines vs. Ohio State Buckeyes "+tt.expected, notification.Message)
+			assert.Equal(t, PriorityLow, notification.Priority)
+		})
+	}
+}
+
+func TestGameWorkflow_FavoriteTrailing(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "favorite_trailing")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// Michigan is favored, and behind all game: the first-half deficit doesn't count, the second half's does, once
+	updates := []Game{
+		{CurrentPeriod: "2", CurrentScore: map[string]string{"130": "3", "194": "7"}},
+		{CurrentPeriod: "3", CurrentScore: map[string]string{"130": "3", "194": "10"}},
+		{CurrentPeriod: "3", CurrentScore: map[string]string{"130": "10", "194": "13"}},
+		{CurrentPeriod: "4", CurrentScore: map[string]string{"130": "10", "194": "16"}},
+	}
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		update := updates[min(polls, len(updates)-1)]
+		polls++
+		return update, nil
+	})
+
+	var sent []Notification
+	var sentAtPoll []int
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		sentAtPoll = append(sentAtPoll, polls)
+		return nil
+	})
+
+	// Leave 20 minutes of monitoring, so we get four polls
+	game := Game{
+		ID:              "test-game-favorite-trailing",
+		Sport:           "football",
+		League:          "college-football",
+		StartTime:       workflowStart.Add(-5 * time.Hour).Add(20 * time.Minute),
+		Status:          "in",
+		NumberOfPeriods: 4,
+		TVNetwork:       "FOX",
+		CurrentScore:    map[string]string{"130": "0", "194": "0"},
+		HomeTeam:        Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH", Favorite: true},
+		AwayTeam:        Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU", Underdog: true},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, 4, polls)
+
+	require.Len(t, sent, 1)
+	assert.Equal(t, []int{2}, sentAtPoll)
+	assert.Equal(t, "Upset Alert!", sent[0].Title)
+	assert.Equal(t, PriorityHigh, sent[0].Priority)
+	assert.Contains(t, sent[0].Message, "Michigan Wolverines are favored but trailing")
+	assert.Contains(t, sent[0].Message, "MICH 3 - OSU 10")
+}
+
+func TestFavoriteTrailingFromPeriod(t *testing.T) {
+	tests := []struct {
+		name     string
+		game     Game
+		expected int
+	}{
+		{"football quarters", Game{NumberOfPeriods: 4}, 3},
+		{"college basketball halves", Game{NumberOfPeriods: 2}, 2},
+		{"baseball innings", Game{NumberOfPeriods: 9}, 5},
+		{"configured", Game{NumberOfPeriods: 4, FavoriteTrailingFromPeriod: 4}, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, favoriteTrailingFromPeriod(tt.game))
+		})
+	}
+}
+
+func TestGameWorkflow_UnderdogWithoutOdds(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "underdog")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	logger := &recordingLogger{Logger: log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), message: "No odds for this game, underdog alerts are disabled for it"}
+	testSuite := &testsuite.WorkflowTestSuite{}
+	testSuite.SetLogger(logger)
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2025, 3, 1, 19, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// The away team takes the lead, but with no odds nobody is the underdog
+	scores := []map[string]string{
+		{"130": "10", "194": "12"},
+		{"130": "12", "194": "20"},
+		{"130": "14", "194": "25"},
+	}
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		score := scores[min(polls, len(scores)-1)]
+		polls++
+		return Game{CurrentPeriod: "2", CurrentScore: score}, nil
+	})
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	// Leave 15 minutes of monitoring, so we get three polls
+	game := Game{
+		ID:           "test-game-no-odds",
+		Sport:        "basketball",
+		League:       "womens-college-basketball",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(15 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "8", "194": "8"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, 3, polls)
+
+	// Warned once, and nothing sent
+	require.Len(t, logger.lines, 1)
+	assert.Equal(t, "test-game-no-odds", keyval(logger.lines[0], "gameID"))
+	env.AssertNotCalled(t, "SendNotificationListActivity", mock.Anything, mock.Anything)
+}
+
+func TestGameWorkflow_ScoreChangeOrderIsStable(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	// Map iteration order changes from run to run, so run it a few times: the changed teams have to come out
+	// in the same (sorted) order every time
+	for run := 0; run < 10; run++ {
+		logger := &recordingLogger{Logger: log.NewStructuredLogger(slog.New(slog.NewTextHandler(io.Discard, nil))), message: "Score change detected"}
+		testSuite := &testsuite.WorkflowTestSuite{}
+		testSuite.SetLogger(logger)
 		env := testSuite.NewTestWorkflowEnvironment()
-		env.OnActivity(GetGameScoreActivity, mock.Anything).Return(nil)
+		workflowStart := time.Date(2025, 3, 1, 19, 0, 0, 0, time.UTC)
+		env.SetStartTime(workflowStart)
+
+		env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+			CurrentPeriod: "2",
+			CurrentScore:  map[string]string{"52": "3", "130": "7", "194": "10", "2": "0", "61": "14"},
+		}, nil)
+		env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil)
+
+		// Leave 5 minutes of monitoring, so we get one poll
+		game := Game{
+			ID:           "test-game-order",
+			Sport:        "football",
+			League:       "college-football",
+			StartTime:    workflowStart.Add(-5 * time.Hour).Add(5 * time.Minute),
+			Status:       "in",
+			CurrentScore: map[string]string{"52": "0", "130": "0", "194": "0", "2": "0", "61": "0"},
+			HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines"},
+			AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+		}
 
 		env.ExecuteWorkflow(GameWorkflow, game)
+
+		require.True(t, env.IsWorkflowCompleted())
+		require.NoError(t, env.GetWorkflowError())
+		require.Len(t, logger.lines, 1)
+		assert.Equal(t, []string{"130", "194", "52", "61"}, keyval(logger.lines[0], "changedTeamIDs"), "run %d", run)
+	}
+}
+
+func TestSortedTeamIDs(t *testing.T) {
+	assert.Equal(t, []string{"130", "194", "2"}, sortedTeamIDs(map[string]string{"2": "0", "194": "7", "130": "3"}))
+	assert.Empty(t, sortedTeamIDs(nil))
+}
+
+func TestGameWorkflow_StopTracking(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		startTime     time.Time
+		stopAfter     time.Duration
+		expectedPolls int
+	}{
+		{"while monitoring", workflowStart, 7 * time.Minute, 1},
+		{"before the game starts", workflowStart.Add(2 * time.Hour), time.Hour, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			env.SetStartTime(workflowStart)
+
+			polls := 0
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+				polls++
+				return Game{CurrentPeriod: "1", CurrentScore: map[string]string{"130": "0", "194": "0"}}, nil
+			}).Maybe()
+
+			var stoppedAt time.Time
+			env.RegisterDelayedCallback(func() {
+				stoppedAt = env.Now()
+				env.SignalWorkflow(StopTrackingSignal, nil)
+			}, tt.stopAfter)
+
+			game := Game{
+				ID:           "test-game-stop",
+				StartTime:    tt.startTime,
+				Status:       "pre",
+				CurrentScore: map[string]string{"130": "0", "194": "0"},
+				HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+				AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+			}
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			assert.Equal(t, tt.expectedPolls, polls)
+			// Finished as soon as it was asked to, not hours later
+			assert.Equal(t, stoppedAt, env.Now())
+
+			var result string
+			require.NoError(t, env.GetWorkflowResult(&result))
+			assert.Equal(t, "Final score: MICH 0 - OSU 0", result)
+		})
+	}
+}
+
+func TestGameWorkflow_UpdateStartTime(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	workflowStart := time.Date(2024, 11, 30, 14, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		newStartTime time.Time
+	}{
+		{"moved earlier", workflowStart.Add(time.Hour)},
+		{"already started", workflowStart.Add(-10 * time.Minute)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			env.SetStartTime(workflowStart)
+
+			var polls []time.Time
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+				polls = append(polls, env.Now())
+				return Game{CurrentPeriod: "1", CurrentScore: map[string]string{"130": "0", "194": "0"}}, nil
+			})
+
+			// Half an hour in, ESPN moves the kickoff from 5pm
+			signalTime := workflowStart.Add(30 * time.Minute)
+			env.RegisterDelayedCallback(func() {
+				env.SignalWorkflow(UpdateStartTimeSignal, tt.newStartTime)
+			}, 30*time.Minute)
+
+			game := Game{
+				ID:           "test-game-moved",
+				StartTime:    workflowStart.Add(3 * time.Hour),
+				Status:       "pre",
+				CurrentScore: map[string]string{"130": "0", "194": "0"},
+				HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+				AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+			}
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			require.NotEmpty(t, polls)
+
+			// Polling starts a poll interval (plus jitter) after the new start, or after the signal if that's passed,
+			// and the five hour window runs from the new start too
+			pollingFrom := tt.newStartTime
+			if signalTime.After(pollingFrom) {
+				pollingFrom = signalTime
+			}
+			firstPoll := polls[0].Sub(pollingFrom)
+			assert.GreaterOrEqual(t, firstPoll, pollInterval)
+			assert.Less(t, firstPoll, pollInterval+maxPollJitter)
+			assert.False(t, env.Now().After(tt.newStartTime.Add(5*time.Hour+pollInterval)))
+		})
+	}
+}
+
+func TestGameWorkflow_NotificationHistory(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger,slack")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// The home team scores on the first two polls only
+	var pollTimes []time.Time
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		pollTimes = append(pollTimes, env.Now())
+		homeScore := min(len(pollTimes), 2) * 7
+		return Game{CurrentPeriod: "2", CurrentScore: map[string]string{"130": strconv.Itoa(homeScore), "194": "0"}}, nil
+	})
+
+	// Slack misses the second one
+	slackSends := 0
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		if sendNotifications.Channel == "slack" {
+			slackSends++
+			if slackSends == 2 {
+				return temporal.NewNonRetryableApplicationError("slack is down", "SlackError", nil)
+			}
+		}
+		return nil
+	})
+
+	// Leave 20 minutes of monitoring, so we get polls at 5, 10, 15 and 20 minutes
+	game := Game{
+		ID:           "test-game-history",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(20 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.GreaterOrEqual(t, len(pollTimes), 2)
+
+	encoded, err := env.QueryWorkflow(NotificationHistoryQuery)
+	require.NoError(t, err)
+	var history []SentNotification
+	require.NoError(t, encoded.Get(&history))
+
+	require.Len(t, history, 2)
+	assert.Equal(t, "Score Update!", history[0].Title)
+	assert.Contains(t, history[0].Message, "MICH 7 - OSU 0")
+	assert.True(t, pollTimes[0].Equal(history[0].SentAt))
+	assert.Equal(t, []string{"logger", "slack"}, history[0].Channels)
+	assert.Contains(t, history[1].Message, "MICH 14 - OSU 0")
+	assert.True(t, pollTimes[1].Equal(history[1].SentAt))
+	assert.Equal(t, []string{"logger"}, history[1].Channels)
+}
+
+func TestGameWorkflow_Digest(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change,final")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger,slack")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// The home team scores twice, then the game goes final
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		polls++
+		homeScore := min(polls, 2) * 7
+		status := "in"
+		if polls >= 3 {
+			status = "post"
+		}
+		return Game{CurrentPeriod: "4", Status: status, CurrentScore: map[string]string{"130": strconv.Itoa(homeScore), "194": "3"}}, nil
+	})
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	var reported []DigestEntry
+	env.OnSignalExternalWorkflow(mock.Anything, "collection-1-digest", "", DigestGameResultSignal, mock.Anything).Return(
+		func(namespace, workflowID, runID, signalName string, arg interface{}) error {
+			reported = append(reported, arg.(DigestEntry))
+			return nil
+		})
+
+	game := Game{
+		ID:               "test-game-digest",
+		StartTime:        workflowStart.Add(-time.Hour),
+		Status:           "in",
+		CurrentScore:     map[string]string{"130": "0", "194": "3"},
+		HomeTeam:         Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:         Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+		DigestWorkflowID: "collection-1-digest",
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// Nothing goes out live - it's all handed to the digest with the final score
+	env.AssertNotCalled(t, "SendNotificationListActivity", mock.Anything, mock.Anything)
+	require.Len(t, reported, 1)
+	entry := reported[0]
+	assert.Equal(t, "test-game-digest", entry.Result.GameID)
+	assert.Equal(t, "14", entry.Result.HomeScore)
+	assert.Equal(t, "3", entry.Result.AwayScore)
+	titles := make([]string, 0, len(entry.Notifications))
+	for _, notification := range entry.Notifications {
+		titles = append(titles, notification.Title)
+		assert.Empty(t, notification.Channels)
+	}
+	assert.Equal(t, []string{"Score Update!", "Score Update!", "Final!"}, titles)
+}
+
+func TestRecordNotifications(t *testing.T) {
+	sentAt := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	game := Game{NotificationHistory: []SentNotification{{Title: "Earlier"}}}
+
+	// Nothing was delivered, so nothing was sent
+	recordNotifications(&game, []Notification{{Title: "Lost"}}, nil, sentAt)
+	require.Len(t, game.NotificationHistory, 1)
+
+	recordNotifications(&game, []Notification{{Title: "Score Update!", Message: "MICH 7 - OSU 0"}, {Title: "Team Chaos!"}}, []string{"hass"}, sentAt)
+	assert.Equal(t, []SentNotification{
+		{Title: "Earlier"},
+		{Title: "Score Update!", Message: "MICH 7 - OSU 0", SentAt: sentAt, Channels: []string{"hass"}},
+		{Title: "Team Chaos!", SentAt: sentAt, Channels: []string{"hass"}},
+	}, game.NotificationHistory)
+}
+
+func TestScoreDelta(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     map[string]string
+		to       map[string]string
+		expected int
+	}{
+		{"no change", map[string]string{"130": "7", "194": "3"}, map[string]string{"130": "7", "194": "3"}, 0},
+		{"both teams scored", map[string]string{"130": "7", "194": "3"}, map[string]string{"130": "14", "194": "6"}, 10},
+		{"score correction", map[string]string{"130": "10"}, map[string]string{"130": "7"}, 3},
+		{"nothing to compare against", nil, map[string]string{"130": "2"}, 2},
+		{"unparseable score", map[string]string{"130": "7"}, map[string]string{"130": ""}, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, scoreDelta(tt.from, tt.to))
+		})
+	}
+}
+
+func TestGameWorkflow_BatchNotifications(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	tests := []struct {
+		name          string
+		batch         bool
+		expectedSends []int // notifications in each send
+	}{
+		{"batching off", false, []int{1, 1}},
+		{"batching on", true, []int{1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+			env.SetStartTime(workflowStart)
+
+			// The home team scores on the first two polls, then nothing changes
+			polls := 0
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+				polls++
+				homeScore := 7 * min(polls, 2)
+				return Game{
+					CurrentPeriod: "2",
+					CurrentScore:  map[string]string{"130": strconv.Itoa(homeScore), "194": "0"},
+				}, nil
+			})
+
+			var sends []SendNotifications
+			env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+				sends = append(sends, sendNotifications)
+				return nil
+			})
+
+			// Leave 20 minutes of monitoring, so we get four polls
+			game := Game{
+				ID:                 "test-game-batch",
+				StartTime:          workflowStart.Add(-5 * time.Hour).Add(20 * time.Minute),
+				Status:             "in",
+				BatchNotifications: tt.batch,
+				CurrentScore:       map[string]string{"130": "0", "194": "0"},
+				HomeTeam:           Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+				AwayTeam:           Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			require.Len(t, sends, len(tt.expectedSends))
+			for i, count := range tt.expectedSends {
+				assert.Len(t, sends[i].NotificationList, count)
+			}
+			if tt.batch {
+				// Both score updates are in the one combined message
+				assert.Equal(t, 2, strings.Count(sends[0].NotificationList[0].Message, "Score Update!"))
+				assert.Contains(t, sends[0].NotificationList[0].Message, "MICH 14 - OSU 0")
+			}
+		})
+	}
+}
+
+func TestCombineNotifications(t *testing.T) {
+	scoreCard := &ScoreCard{Score: "MICH 14 - OSU 7"}
+	combined := combineNotifications([]Notification{
+		{Title: "Score Update!", Message: "first", Priority: PriorityNormal},
+		{Title: "Team Chaos!", Message: "second", Priority: PriorityHigh, ScoreCard: scoreCard},
+	})
+
+	assert.Equal(t, "Game Updates", combined.Title)
+	assert.Equal(t, "Score Update!\nfirst\n\nTeam Chaos!\nsecond", combined.Message)
+	assert.Equal(t, PriorityHigh, combined.Priority)
+	assert.Equal(t, scoreCard, combined.ScoreCard)
+}
+
+func TestGameWorkflow_PollJitter(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	var polls []time.Time
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		polls = append(polls, env.Now())
+		return Game{CurrentScore: map[string]string{"130": "0", "194": "0"}}, nil
+	})
+
+	// Already underway, with 20 minutes of monitoring left
+	game := Game{
+		ID:           "test-game-jitter",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(20 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	require.NotEmpty(t, polls)
+
+	// The first poll lands inside the jitter window...
+	firstPoll := polls[0].Sub(workflowStart)
+	assert.GreaterOrEqual(t, firstPoll, pollInterval)
+	assert.Less(t, firstPoll, pollInterval+maxPollJitter)
+
+	// ...and the rest keep the same offset, still 5 minutes apart
+	for i := 1; i < len(polls); i++ {
+		assert.Equal(t, pollInterval, polls[i].Sub(polls[i-1]))
+	}
+}
+
+func TestGameWorkflow_RecordResult(t *testing.T) {
+	for _, recordResult := range []bool{true, false} {
+		t.Run(fmt.Sprintf("recordResult=%v", recordResult), func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+			env.SetStartTime(workflowStart)
+
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+				CurrentPeriod: "4",
+				CurrentScore:  map[string]string{"130": "13", "194": "10"},
+			}, nil)
+			env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(nil)
+
+			var results []GameResult
+			env.OnActivity(RecordGameResultActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, result GameResult) error {
+				results = append(results, result)
+				return nil
+			})
+
+			// Already underway, with 10 minutes of monitoring left
+			game := Game{
+				ID:           "401520281",
+				Sport:        "football",
+				League:       "college-football",
+				StartTime:    workflowStart.Add(-5 * time.Hour).Add(10 * time.Minute),
+				Status:       "in",
+				CurrentScore: map[string]string{"130": "0", "194": "0"},
+				HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+				AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+				RecordResult: recordResult,
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+
+			if !recordResult {
+				assert.Empty(t, results)
+				return
+			}
+			require.Len(t, results, 1)
+			assert.Equal(t, "401520281", results[0].GameID)
+			assert.Equal(t, "Michigan Wolverines", results[0].HomeTeam)
+			assert.Equal(t, "13", results[0].HomeScore)
+			assert.Equal(t, "Ohio State Buckeyes", results[0].AwayTeam)
+			assert.Equal(t, "10", results[0].AwayScore)
+			assert.Equal(t, game.StartTime, results[0].StartTime)
+		})
+	}
+}
+
+func TestGameWorkflow_NextPollQuery(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+	}, nil)
+
+	// Already underway, with 20 minutes of monitoring left
+	game := Game{
+		ID:           "test-game-next-poll",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(20 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+	}
+
+	var queriedAt time.Time
+	var nextPoll time.Time
+	env.RegisterDelayedCallback(func() {
+		queriedAt = env.Now()
+		result, err := env.QueryWorkflow("nextPoll")
+		require.NoError(t, err)
+		require.NoError(t, result.Get(&nextPoll))
+	}, 7*time.Minute)
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+
+	// Between polls, so the next one is ahead of us but no more than one interval away
+	assert.True(t, nextPoll.After(queriedAt), "next poll %v should be after %v", nextPoll, queriedAt)
+	assert.LessOrEqual(t, nextPoll.Sub(queriedAt), pollInterval)
+}
+
+func TestGameWorkflow_RecordResultMonitorTimes(t *testing.T) {
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name                 string
+		gameStart            time.Time
+		expectedMonitorStart time.Time
+	}{
+		{
+			name:                 "scheduled ahead of kickoff",
+			gameStart:            workflowStart.Add(time.Hour),
+			expectedMonitorStart: workflowStart.Add(time.Hour),
+		},
+		{
+			name:                 "tracked late",
+			gameStart:            workflowStart.Add(-4 * time.Hour),
+			expectedMonitorStart: workflowStart,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			env.SetStartTime(workflowStart)
+
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+				CurrentScore: map[string]string{"130": "0", "194": "0"},
+			}, nil)
+
+			var results []GameResult
+			env.OnActivity(RecordGameResultActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, result GameResult) error {
+				results = append(results, result)
+				return nil
+			})
+
+			game := Game{
+				ID:           "401520281",
+				StartTime:    tt.gameStart,
+				Status:       "pre",
+				CurrentScore: map[string]string{"130": "0", "194": "0"},
+				HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines"},
+				AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+				RecordResult: true,
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			require.Len(t, results, 1)
+
+			result := results[0]
+			assert.Equal(t, tt.expectedMonitorStart, result.MonitorStart.UTC())
+			assert.True(t, result.MonitorEnd.After(result.MonitorStart), "monitor end %v should be after start %v", result.MonitorEnd, result.MonitorStart)
+			// Monitoring runs until 5 hours after kickoff
+			assert.False(t, result.MonitorEnd.Before(tt.gameStart.Add(5*time.Hour)))
+		})
+	}
+}
+
+func TestGameWorkflow_SearchAttributes(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{}, nil)
+	env.OnUpsertTypedSearchAttributes(temporal.NewSearchAttributes(
+		SportSearchAttribute.ValueSet("football"),
+		LeagueSearchAttribute.ValueSet("college-football"),
+		HomeTeamIDSearchAttribute.ValueSet("130"),
+		AwayTeamIDSearchAttribute.ValueSet("194"),
+	)).Return(nil).Once()
+
+	game := Game{
+		ID:        "test-game-search-attributes",
+		Sport:     "football",
+		League:    "college-football",
+		StartTime: time.Now().Add(-time.Hour),
+		Status:    "in",
+		HomeTeam:  Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:  Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	assert.True(t, env.IsWorkflowCompleted())
+	assert.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+}
+
+// Benchmark test for workflow execution
+func BenchmarkGameWorkflow(b *testing.B) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+
+	game := Game{
+		ID:        "benchmark-game",
+		StartTime: time.Now().Add(-time.Hour),
+		Status:    "in",
+		HomeTeam: Team{
+			ID:          "130",
+			DisplayName: "Michigan Wolverines",
+		},
+		AwayTeam: Team{
+			ID:          "264",
+			DisplayName: "Washington Huskies",
+		},
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		env := testSuite.NewTestWorkflowEnvironment()
+		env.OnActivity(GetGameScoreActivity, mock.Anything).Return(nil)
+
+		env.ExecuteWorkflow(GameWorkflow, game)
+	}
+}
+
+func TestNotificationPriority(t *testing.T) {
+	game := Game{
+		ID:              "401520281",
+		Sport:           "football",
+		HomeTeam:        Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:        Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+		CurrentScore:    map[string]string{"130": "21", "194": "14"},
+		CurrentPeriod:   "5",
+		NumberOfPeriods: 4,
+	}
+
+	tests := []struct {
+		name             string
+		notification     Notification
+		expectedPriority string
+	}{
+		{"score change", buildScoreUpdateNotification(game), PriorityNormal},
+		{"underdog", buildUnderdogNotification(game, game.HomeTeam.DisplayName), PriorityHigh},
+		{"overtime", buildOvertimeNotification(game), PriorityHigh},
+		{"final", buildFinalNotification(game), PriorityHigh},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedPriority, tt.notification.Priority)
+		})
+	}
+}
+
+func TestNotificationGameLink(t *testing.T) {
+	game := Game{
+		ID:              "401520281",
+		Sport:           "football",
+		GameURL:         "https://www.espn.com/college-football/game/_/gameId/401520281",
+		HomeTeam:        Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:        Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+		CurrentScore:    map[string]string{"130": "21", "194": "14"},
+		CurrentPeriod:   "5",
+		NumberOfPeriods: 4,
+	}
+
+	for _, notification := range []Notification{
+		buildScoreUpdateNotification(game),
+		buildUnderdogNotification(game, game.HomeTeam.DisplayName),
+		buildOvertimeNotification(game),
+	} {
+		assert.True(t, strings.HasSuffix(notification.Message, "\n"+game.GameURL), notification.Message)
+		assert.Equal(t, game.GameURL, notification.ScoreCard.GameURL)
+	}
+
+	// No URL, no trailing link
+	game.GameURL = ""
+	assert.False(t, strings.HasSuffix(buildScoreUpdateNotification(game).Message, "\n"))
+}
+
+func TestNotificationMissingScore(t *testing.T) {
+	game := Game{
+		ID:       "401520281",
+		Sport:    "football",
+		HomeTeam: Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam: Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+		// ESPN sent a different ID for Michigan, so there's no home score
+		CurrentScore:  map[string]string{"9999": "21", "194": "14"},
+		CurrentPeriod: "3",
+		DisplayClock:  "4:12",
+	}
+
+	notification := buildScoreUpdateNotification(game)
+	assert.Contains(t, notification.Message, "Score: MICH — - OSU 14")
+	assert.NotContains(t, notification.Message, "MICH  -")
+	assert.Equal(t, "MICH — - OSU 14", notification.ScoreCard.Score)
+	assert.Equal(t, []string{"130"}, missingScoreTeams(game))
+
+	game.CurrentScore = nil
+	assert.Equal(t, "MICH — - OSU —", scoreLine(game))
+	assert.Equal(t, []string{"130", "194"}, missingScoreTeams(game))
+
+	game.CurrentScore = map[string]string{"130": "21", "194": "14"}
+	assert.Equal(t, "MICH 21 - OSU 14", scoreLine(game))
+	assert.Empty(t, missingScoreTeams(game))
+}
+
+func TestNotificationStatusDetail(t *testing.T) {
+	game := Game{
+		ID:              "401520281",
+		Sport:           "football",
+		HomeTeam:        Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:        Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+		CurrentScore:    map[string]string{"130": "21", "194": "14"},
+		CurrentPeriod:   "3",
+		DisplayClock:    "4:12",
+		NumberOfPeriods: 4,
+	}
+
+	tests := []struct {
+		name         string
+		statusDetail string
+		expected     string
+	}{
+		{"falls back to computed period", "", "Q3, 4:12 left"},
+		{"prefers ESPN detail", "4:12 - 3rd Quarter", "4:12 - 3rd Quarter"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game.StatusDetail = tt.statusDetail
+
+			assert.Contains(t, buildScoreUpdateNotification(game).Message, "\n"+tt.expected+" on ")
+			assert.Contains(t, buildUnderdogNotification(game, game.AwayTeam.DisplayName).Message, "It's currently "+tt.expected+".")
+			assert.Equal(t, tt.expected, newScoreCard(game).Period)
+		})
+	}
+}
+
+func TestGameWorkflow_FocusTeams(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "score_change,underdog,overtime")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	tests := []struct {
+		name           string
+		focusTeams     []string
+		expectedTitles []string
+	}{
+		{
+			name:           "unrelated matchup only gets overtime",
+			focusTeams:     []string{"130", "MSU"},
+			expectedTitles: []string{"OT!"},
+		},
+		{
+			name:           "focus team playing gets everything",
+			focusTeams:     []string{"Auburn"},
+			expectedTitles: []string{"Score Update!", "Team Chaos!", "Score Update!", "OT!"},
+		},
+		{
+			name:           "no focus teams gets everything",
+			expectedTitles: []string{"Score Update!", "Team Chaos!", "Score Update!", "OT!"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+			env.SetStartTime(workflowStart)
+
+			// The underdog goes ahead in the 4th, then it's tied up and goes to overtime
+			updates := []Game{
+				{CurrentPeriod: "4", CurrentScore: map[string]string{"2": "24", "333": "21"}},
+				{CurrentPeriod: "5", CurrentScore: map[string]string{"2": "24", "333": "24"}},
+			}
+			poll := 0
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+				update := updates[min(poll, len(updates)-1)]
+				poll++
+				return update, nil
+			})
+
+			var titles []string
+			env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+				for _, notification := range sendNotifications.NotificationList {
+					titles = append(titles, notification.Title)
+				}
+				return nil
+			})
+
+			// Already underway, with 10 minutes of monitoring left - two polls
+			game := Game{
+				ID:              "401520282",
+				Sport:           "football",
+				StartTime:       workflowStart.Add(-5 * time.Hour).Add(10 * time.Minute),
+				Status:          "in",
+				NumberOfPeriods: 4,
+				CurrentScore:    map[string]string{"2": "17", "333": "21"},
+				HomeTeam:        Team{ID: "2", DisplayName: "Auburn Tigers", Abbreviation: "AUB", Location: "Auburn", Underdog: true},
+				AwayTeam:        Team{ID: "333", DisplayName: "Alabama Crimson Tide", Abbreviation: "ALA", Location: "Alabama", Favorite: true},
+				FocusTeams:      tt.focusTeams,
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			assert.Equal(t, tt.expectedTitles, titles)
+		})
+	}
+}
+
+func TestGetPeriodStr_Basketball(t *testing.T) {
+	tests := []struct {
+		name     string
+		league   string
+		period   string
+		expected string
+	}{
+		{"nba first quarter", "nba", "1", "Q1"},
+		{"nba fourth quarter", "nba", "4", "Q4"},
+		{"wnba third quarter", "wnba", "3", "Q3"},
+		{"women's college quarters", "womens-college-basketball", "2", "Q2"},
+		{"men's college first half", "mens-college-basketball", "1", "1st Half"},
+		{"men's college second half", "mens-college-basketball", "2", "2nd Half"},
+		{"men's college overtime", "mens-college-basketball", "3", "OT"},
+		{"men's college double overtime", "mens-college-basketball", "4", "2OT"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, getPeriodStr(tt.period, "basketball", tt.league))
+		})
+	}
+}
+
+func TestInningStr(t *testing.T) {
+	tests := []struct {
+		name           string
+		period         string
+		detail         string
+		expectedInning string
+		expectedStatus string
+	}{
+		{"top of the 7th", "7", "Top 7th", "Top 7th", "Top 7th"},
+		{"bottom from the long detail", "7", "Bottom 7th", "Bottom 7th", "Bottom 7th"},
+		{"bottom from the short detail", "7", "Bot 7th", "Bottom 7th", "Bottom 7th"},
+		{"middle of the 1st", "1", "Mid 1st", "Middle 1st", "Middle 1st"},
+		{"end of the 8th", "8", "End 8th", "End 8th", "End 8th"},
+		{"extra innings", "11", "Top 11th", "Top 11th", "Top 11th"},
+		{"no status detail", "3", "", "", "3rd Inning"},
+		{"status detail without a half", "9", "Rain Delay", "", "Rain Delay"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := Game{Sport: "baseball", League: "mlb", CurrentPeriod: tt.period, StatusDetail: tt.detail, DisplayClock: "0:00"}
+			assert.Equal(t, tt.expectedInning, inningStr(game))
+			assert.Equal(t, tt.expectedStatus, gameStatusStr(game))
+		})
+	}
+}
+
+func TestOrdinal(t *testing.T) {
+	expected := map[int]string{1: "1st", 2: "2nd", 3: "3rd", 4: "4th", 9: "9th", 11: "11th", 12: "12th", 13: "13th", 21: "21st", 22: "22nd", 112: "112th"}
+	for n, want := range expected {
+		assert.Equal(t, want, ordinal(n))
+	}
+}
+
+func TestGameWorkflow_ExtraInnings(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "overtime")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 9, 14, 21, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// Bottom of the 9th, then into the 10th
+	statuses := []struct{ period, detail string }{{"9", "Bottom 9th"}, {"10", "Top 10th"}, {"10", "Bottom 10th"}}
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		status := statuses[min(polls, len(statuses)-1)]
+		polls++
+		return Game{
+			CurrentPeriod: status.period,
+			DisplayClock:  "0:00",
+			StatusDetail:  status.detail,
+			CurrentScore:  map[string]string{"6": "3", "5": "3"},
+		}, nil
+	})
+
+	var sent []Notification
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		return nil
+	})
+
+	// Leave 15 minutes of monitoring, so we get three polls
+	game := Game{
+		ID:              "test-game-extra-innings",
+		Sport:           "baseball",
+		League:          "mlb",
+		StartTime:       workflowStart.Add(-5 * time.Hour).Add(15 * time.Minute),
+		Status:          "in",
+		NumberOfPeriods: 9,
+		CurrentScore:    map[string]string{"6": "3", "5": "3"},
+		HomeTeam:        Team{ID: "6", DisplayName: "Detroit Tigers", Abbreviation: "DET"},
+		AwayTeam:        Team{ID: "5", DisplayName: "Cleveland Guardians", Abbreviation: "CLE"},
+		TVNetwork:       "ESPN",
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, 3, polls)
+
+	// Once for the 10th, not again for the bottom half
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Extra Innings!", sent[0].Title)
+	assert.Contains(t, sent[0].Message, "in extra innings (Top 10th) on ESPN!")
+	assert.NotContains(t, sent[0].Message, "OT")
+	assert.Equal(t, "Top 10th", sent[0].ScoreCard.Period)
+}
+
+func TestRegulationPeriods_Overtime(t *testing.T) {
+	tests := []struct {
+		name            string
+		league          string
+		expectedPeriods int
+		overtimePeriod  string
+	}{
+		{"nba", "nba", 4, "5"},
+		{"wnba", "wnba", 4, "5"},
+		{"men's college", "mens-college-basketball", 2, "3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			periods := regulationPeriods("basketball", tt.league)
+			assert.Equal(t, tt.expectedPeriods, periods)
+
+			// The first period after regulation is the first overtime
+			game := Game{
+				Sport:           "basketball",
+				League:          tt.league,
+				CurrentPeriod:   tt.overtimePeriod,
+				NumberOfPeriods: periods,
+				CurrentScore:    map[string]string{},
+			}
+			assert.Equal(t, "OT!", buildOvertimeNotification(game).Title)
+		})
+	}
+}
+
+func TestGameWorkflow_WinProbability(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "win_probability")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+		CurrentPeriod: "3",
+		CurrentScore:  map[string]string{"130": "14", "194": "17"},
+	}, nil)
+
+	// Michigan (home) goes from a 40% to a 60% chance of winning, and stays there
+	homeWinPercentages := []float64{0.4, 0.6, 0.6}
+	polls := 0
+	env.OnActivity(GetWinProbabilityActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (WinProbability, error) {
+		percentage := homeWinPercentages[min(polls, len(homeWinPercentages)-1)]
+		polls++
+		return WinProbability{HomeWinPercentage: percentage}, nil
+	})
+
+	var sent []Notification
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		return nil
+	})
+
+	// Leave 15 minutes of monitoring, so we get three polls
+	game := Game{
+		ID:           "test-game-win-probability",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(15 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "14", "194": "17"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.GreaterOrEqual(t, polls, 2)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Momentum Swing!", sent[0].Title)
+	assert.Contains(t, sent[0].Message, "Michigan Wolverines now have a 60% chance to win")
+}
+
+func TestGameWorkflow_ScoringPlays(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "scoring_play")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	touchdown := ScoringPlay{ID: "p1", Text: "Blake Corum 12 Yd Run (James Turner Kick)"}
+	touchdown.Type.Text = "Touchdown"
+	fieldGoal := ScoringPlay{ID: "p2", Text: "Jayden Fielding 38 Yd Field Goal"}
+	fieldGoal.Type.Text = "Field Goal"
+
+	tests := []struct {
+		name              string
+		lastScoringPlayID string
+		summaries         [][]ScoringPlay
+	}{
+		{
+			name:              "touchdown already seen",
+			lastScoringPlayID: "p1",
+			summaries:         [][]ScoringPlay{{touchdown, fieldGoal}},
+		},
+		{
+			name:      "touchdown was before monitoring started",
+			summaries: [][]ScoringPlay{{touchdown}, {touchdown, fieldGoal}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+			env.SetStartTime(workflowStart)
+
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(Game{
+				CurrentPeriod: "2",
+				CurrentScore:  map[string]string{"130": "7", "194": "3"},
+			}, nil)
+
+			polls := 0
+			env.OnActivity(GetScoringPlaysActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) ([]ScoringPlay, error) {
+				plays := tt.summaries[min(polls, len(tt.summaries)-1)]
+				polls++
+				return plays, nil
+			})
+
+			var sent []Notification
+			env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+				sent = append(sent, sendNotifications.NotificationList...)
+				return nil
+			})
+
+			// Picked up with Michigan's touchdown on the board. Leave 15 minutes of monitoring, so we get three polls.
+			game := Game{
+				ID:                "test-game-scoring-plays",
+				StartTime:         workflowStart.Add(-5 * time.Hour).Add(15 * time.Minute),
+				Status:            "in",
+				CurrentScore:      map[string]string{"130": "7", "194": "0"},
+				HomeTeam:          Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+				AwayTeam:          Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+				LastScoringPlayID: tt.lastScoringPlayID,
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			assert.Equal(t, 3, polls)
+
+			// Only the field goal is new
+			require.Len(t, sent, 1)
+			assert.Equal(t, "Field Goal!", sent[0].Title)
+			assert.Equal(t, "Jayden Fielding 38 Yd Field Goal\nScore: MICH 7 - OSU 3", sent[0].Message)
+		})
+	}
+}
+
+func TestNewScoringPlays(t *testing.T) {
+	plays := []ScoringPlay{{ID: "p1"}, {ID: "p2"}, {ID: "p3"}}
+
+	tests := []struct {
+		name       string
+		lastPlayID string
+		expected   []ScoringPlay
+	}{
+		{"nothing seen yet", "", plays},
+		{"some new", "p1", plays[1:]},
+		{"all seen", "p3", []ScoringPlay{}},
+		{"last play no longer listed", "p9", plays},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, newScoringPlays(plays, tt.lastPlayID))
+		})
+	}
+}
+
+func TestWinProbabilityLeader(t *testing.T) {
+	tests := []struct {
+		name           string
+		winProbability WinProbability
+		threshold      float64
+		expected       string
+	}{
+		{"home favored", WinProbability{HomeWinPercentage: 0.6}, 0.5, "home"},
+		{"away favored", WinProbability{HomeWinPercentage: 0.4}, 0.5, "away"},
+		{"dead even", WinProbability{HomeWinPercentage: 0.5}, 0.5, ""},
+		{"under a higher threshold", WinProbability{HomeWinPercentage: 0.6}, 0.7, ""},
+		{"tie chance counts against away", WinProbability{HomeWinPercentage: 0.3, TiePercentage: 0.3}, 0.5, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, winProbabilityLeader(tt.winProbability, tt.threshold))
+		})
+	}
+}
+
+func TestGameWorkflow_UnderdogCooldown(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "underdog")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	// Auburn (the underdog) takes the lead twice in the 2nd quarter, then again in the 3rd
+	updates := []Game{
+		{CurrentPeriod: "2", CurrentScore: map[string]string{"2": "7", "333": "0"}},
+		{CurrentPeriod: "2", CurrentScore: map[string]string{"2": "7", "333": "14"}},
+		{CurrentPeriod: "2", CurrentScore: map[string]string{"2": "21", "333": "14"}},
+		{CurrentPeriod: "3", CurrentScore: map[string]string{"2": "21", "333": "28"}},
+		{CurrentPeriod: "3", CurrentScore: map[string]string{"2": "35", "333": "28"}},
+	}
+
+	tests := []struct {
+		name              string
+		cooldownPeriods   int
+		expectedUnderdogs int
+	}{
+		{"default is once per period", 0, 2},
+		{"two periods", 2, 1},
+		{"no cooldown", -1, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testSuite := &testsuite.WorkflowTestSuite{}
+			env := testSuite.NewTestWorkflowEnvironment()
+			workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+			env.SetStartTime(workflowStart)
+
+			polls := 0
+			env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+				update := updates[min(polls, len(updates)-1)]
+				polls++
+				return update, nil
+			})
+
+			underdogs := 0
+			env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+				for _, notification := range sendNotifications.NotificationList {
+					if notification.Title == "Team Chaos!" {
+						underdogs++
+					}
+				}
+				return nil
+			})
+
+			// Leave 25 minutes of monitoring, so we get all five updates
+			game := Game{
+				ID:                      "test-game-underdog-cooldown",
+				StartTime:               workflowStart.Add(-5 * time.Hour).Add(25 * time.Minute),
+				Status:                  "in",
+				UnderdogCooldownPeriods: tt.cooldownPeriods,
+				CurrentScore:            map[string]string{"2": "0", "333": "0"},
+				HomeTeam:                Team{ID: "2", DisplayName: "Auburn Tigers", Abbreviation: "AUB", Underdog: true},
+				AwayTeam:                Team{ID: "333", DisplayName: "Alabama Crimson Tide", Abbreviation: "ALA"},
+			}
+
+			env.ExecuteWorkflow(GameWorkflow, game)
+
+			require.True(t, env.IsWorkflowCompleted())
+			require.NoError(t, env.GetWorkflowError())
+			require.GreaterOrEqual(t, polls, len(updates))
+			assert.Equal(t, tt.expectedUnderdogs, underdogs)
+		})
+	}
+}
+
+func TestGameWorkflow_FinalMinutes(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "final_minutes")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// Into the final two minutes on the second poll, and still there on the third
+	clocks := []string{"3:10", "1:45", "0:50"}
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		clock := clocks[min(polls, len(clocks)-1)]
+		polls++
+		return Game{
+			CurrentPeriod: "4",
+			DisplayClock:  clock,
+			CurrentScore:  map[string]string{"130": "24", "194": "21"},
+		}, nil
+	})
+
+	var sent []Notification
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		return nil
+	})
+
+	// Leave 15 minutes of monitoring, so we get three polls
+	game := Game{
+		ID:              "test-game-final-minutes",
+		StartTime:       workflowStart.Add(-5 * time.Hour).Add(15 * time.Minute),
+		Status:          "in",
+		NumberOfPeriods: 4,
+		CurrentScore:    map[string]string{"130": "24", "194": "21"},
+		HomeTeam:        Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:        Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, 3, polls)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Final Minutes!", sent[0].Title)
+}
+
+func TestInFinalMinutes(t *testing.T) {
+	tests := []struct {
+		name     string
+		period   string
+		clock    string
+		expected bool
+	}{
+		{"last quarter under two minutes", "4", "1:45", true},
+		{"last quarter with time left", "4", "2:00", false},
+		{"earlier quarter", "2", "1:45", false},
+		{"overtime", "5", "1:45", false},
+		{"clock ran out", "4", "0:00", false},
+		{"no clock", "4", "", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := Game{CurrentPeriod: tt.period, DisplayClock: tt.clock, NumberOfPeriods: 4}
+			assert.Equal(t, tt.expected, inFinalMinutes(game))
+		})
+	}
+}
+
+func TestGameWorkflow_Comeback(t *testing.T) {
+	t.Setenv("NOTIFICATION_TYPES", "comeback")
+	t.Setenv("NOTIFICATION_CHANNELS", "logger")
+
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	workflowStart := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	env.SetStartTime(workflowStart)
+
+	// Michigan falls behind 17-0, then comes back to lead 21-17 and extends it
+	scores := [][2]string{{"0", "7"}, {"0", "17"}, {"14", "17"}, {"21", "17"}, {"28", "17"}}
+	polls := 0
+	env.OnActivity(GetGameScoreActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, game Game) (Game, error) {
+		score := scores[min(polls, len(scores)-1)]
+		polls++
+		return Game{
+			CurrentPeriod: "3",
+			CurrentScore:  map[string]string{"130": score[0], "194": score[1]},
+		}, nil
+	})
+
+	var sent []Notification
+	env.OnActivity(SendNotificationListActivity, mock.Anything, mock.Anything).Return(func(ctx context.Context, sendNotifications SendNotifications) error {
+		sent = append(sent, sendNotifications.NotificationList...)
+		return nil
+	})
+
+	// Leave 25 minutes of monitoring, so we get all five scores
+	game := Game{
+		ID:           "test-game-comeback",
+		StartTime:    workflowStart.Add(-5 * time.Hour).Add(25 * time.Minute),
+		Status:       "in",
+		CurrentScore: map[string]string{"130": "0", "194": "0"},
+		HomeTeam:     Team{ID: "130", DisplayName: "Michigan Wolverines", Abbreviation: "MICH"},
+		AwayTeam:     Team{ID: "194", DisplayName: "Ohio State Buckeyes", Abbreviation: "OSU"},
+	}
+
+	env.ExecuteWorkflow(GameWorkflow, game)
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	assert.Equal(t, len(scores), polls)
+	require.Len(t, sent, 1)
+	assert.Equal(t, "Comeback!", sent[0].Title)
+	assert.Contains(t, sent[0].Message, "The Michigan Wolverines were down by 17")
+}
+
+func TestTrackComeback(t *testing.T) {
+	game := Game{
+		HomeTeam:       Team{ID: "130", DisplayName: "Michigan Wolverines"},
+		AwayTeam:       Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+		ComebackMargin: 10,
+	}
+
+	steps := []struct {
+		home, away   string
+		expectedTeam string
+	}{
+		{"0", "7", ""},
+		{"3", "7", ""},  // only down 7, not enough to count
+		{"3", "13", ""}, // down 10
+		{"14", "13", "Michigan Wolverines"},
+		{"21", "13", ""}, // already had its comeback
+	}
+
+	for _, step := range steps {
+		game.CurrentScore = map[string]string{"130": step.home, "194": step.away}
+		team, _ := trackComeback(&game)
+		if step.expectedTeam == "" {
+			assert.Nil(t, team, "%s-%s", step.home, step.away)
+		} else {
+			require.NotNil(t, team, "%s-%s", step.home, step.away)
+			assert.Equal(t, step.expectedTeam, team.DisplayName)
+		}
 	}
 }
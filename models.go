@@ -1,6 +1,12 @@
 package sports
 
-import "time"
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"time"
+)
 
 
 // ESPN API Response Models
@@ -33,9 +39,11 @@ type Competition struct {
 	ID         string        `json:"id"`
 	Date       ESPNTime      `json:"date"`
 	Competitors []Competitor `json:"competitors"`
-	Odds       []Odd         `json:"odds"`
+	Odds       Odds          `json:"odds"`
 	Status     Status        `json:"status"`
 	Broadcast  string   	 `json:"broadcast"`
+	Broadcasts []Broadcast   `json:"broadcasts"` // Same networks by market, for when Broadcast is left empty
+	NeutralSite bool         `json:"neutralSite"`
 	Format     Format	   	 `json:"format"`
 }
 
@@ -47,8 +55,11 @@ type Regulation struct {
 	NumberOfPeriods int `json:"periods"`
 }
 
+// Broadcast is one market's TV coverage of a competition, e.g. {Market: "national", Names: ["ESPN"]}
 type Broadcast struct {
-	Name   string `json:"name"`
+	Name   string   `json:"name"`
+	Market string   `json:"market"`
+	Names  []string `json:"names"`
 }
 
 type Competitor struct {
@@ -56,6 +67,29 @@ type Competitor struct {
 	Team   Team   `json:"team"`
 	Score  string `json:"score"`
 	HomeAway string `json:"homeAway"`
+	CuratedRank CuratedRank `json:"curatedRank"`
+	Records []TeamRecord `json:"records"`
+	LineScores []LineScore `json:"linescores"` // Points by period, once the game has started
+}
+
+// LineScore is a competitor's points in one period, e.g. {Value: 14, DisplayValue: "14", Period: 3}
+type LineScore struct {
+	Value        float64 `json:"value"`
+	DisplayValue string  `json:"displayValue"`
+	Period       int     `json:"period"` // 1-based. Older responses leave it off and just list the periods in order
+}
+
+// TeamRecord is one of a competitor's records, e.g. {Name: "overall", Type: "total", Summary: "5-1"}.
+// ESPN usually sends home and road records alongside the overall one.
+type TeamRecord struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Summary string `json:"summary"`
+}
+
+// CuratedRank is the poll ranking ESPN attaches to college competitors. Unranked teams come back as 99.
+type CuratedRank struct {
+	Current int `json:"current"`
 }
 
 type Team struct {
@@ -67,6 +101,33 @@ type Team struct {
 	ConferenceId  string `json:"conferenceId"`
 	Favorite      bool
 	Underdog      bool
+	Rank          int // AP/Coaches poll rank, 0 if unranked
+	Record        string // Overall record going into the game, e.g. "5-1", empty if ESPN didn't send one
+	LogoURL       string `json:"logoUrl,omitempty"` // From ESPN's "logo" (scoreboard) or the first of its "logos" (teams endpoint)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface, picking LogoURL out of whichever logo field ESPN sent.
+func (t *Team) UnmarshalJSON(data []byte) error {
+	type team Team // no UnmarshalJSON, so this doesn't recurse
+	var raw struct {
+		team
+		Logo  string `json:"logo"`
+		Logos []struct {
+			Href string `json:"href"`
+		} `json:"logos"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	*t = Team(raw.team)
+	if t.LogoURL == "" {
+		t.LogoURL = raw.Logo
+	}
+	if t.LogoURL == "" && len(raw.Logos) > 0 {
+		t.LogoURL = raw.Logos[0].Href
+	}
+	return nil
 }
 
 type Status struct {
@@ -82,9 +143,84 @@ type StatusType struct {
 	State       string `json:"state"`
 	Completed   bool   `json:"completed"`
 	Description string `json:"description"`
+	Detail      string `json:"detail"`      // ESPN's own status line, e.g. "4:12 - 3rd Quarter" or "Bottom 7th"
+	ShortDetail string `json:"shortDetail"` // Shorter version of Detail, e.g. "4:12 - 3rd" or "Bot 7th"
 }
 
  
+// TeamsResponse is ESPN's teams endpoint (<sport>/<league>/teams), which lists every team in the league whether
+// or not it's playing today
+type TeamsResponse struct {
+	Sports []struct {
+		Leagues []struct {
+			Teams []struct {
+				Team Team `json:"team"`
+			} `json:"teams"`
+		} `json:"leagues"`
+	} `json:"sports"`
+}
+
+// AllTeams flattens the response down to its teams
+func (r TeamsResponse) AllTeams() []Team {
+	var teams []Team
+	for _, sport := range r.Sports {
+		for _, league := range sport.Leagues {
+			for _, entry := range league.Teams {
+				teams = append(teams, entry.Team)
+			}
+		}
+	}
+	return teams
+}
+
+// GroupsResponse is ESPN's groups endpoint - divisions (e.g. FBS), with their conferences as children
+type GroupsResponse struct {
+	Groups []Group `json:"groups"`
+}
+
+// Group is an ESPN group, e.g. {GroupID: "5", Name: "Big Ten Conference", ShortName: "Big Ten", Abbreviation: "big10"}
+type Group struct {
+	GroupID      string  `json:"groupId"`
+	Name         string  `json:"name"`
+	ShortName    string  `json:"shortName"`
+	Abbreviation string  `json:"abbreviation"`
+	Children     []Group `json:"children"`
+}
+
+// SummaryResponse is the part of ESPN's game summary endpoint we use
+type SummaryResponse struct {
+	Header         SummaryHeader    `json:"header"`
+	WinProbability []WinProbability `json:"winprobability"` // One entry per play, oldest first
+	ScoringPlays   []ScoringPlay    `json:"scoringPlays"`   // Oldest first
+}
+
+// SummaryHeader is the summary's overview of the game - the same competition (teams, score, status) as the scoreboard
+type SummaryHeader struct {
+	ID           string        `json:"id"`
+	Competitions []Competition `json:"competitions"`
+}
+
+// ScoringPlay is one entry in the summary's scoring plays, e.g. {Type: {Text: "Touchdown"}, Text: "Blake Corum 12 Yd Run (James Turner Kick)"}
+type ScoringPlay struct {
+	ID   string `json:"id"`
+	Text string `json:"text"`
+	Type struct {
+		Text string `json:"text"`
+	} `json:"type"`
+}
+
+// WinProbability is ESPN's in-game win probability after a play. Percentages are 0-1.
+type WinProbability struct {
+	HomeWinPercentage float64 `json:"homeWinPercentage"`
+	TiePercentage     float64 `json:"tiePercentage"`
+	PlayID            string  `json:"playId"`
+}
+
+// AwayWinPercentage is whatever's left after the home team and a tie
+func (w WinProbability) AwayWinPercentage() float64 {
+	return 1 - w.HomeWinPercentage - w.TiePercentage
+}
+
 // Odd represents betting odds information for a competition
 type Odd struct {
 	Details       string    `json:"details"` // Abbreviation that indicates the projected winner and how many points they'll win by, i.e. "MICH -7.5" = U of M will win by 7.5 points
@@ -93,6 +229,33 @@ type Odd struct {
 	AwayTeamOdds  *TeamOdds `json:"awayTeamOdds,omitempty"`
 }
 
+// Odds is a competition's odds. ESPN usually sends a list, but some responses have a single object instead,
+// so both are accepted.
+type Odds []Odd
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+func (o *Odds) UnmarshalJSON(b []byte) error {
+	trimmed := bytes.TrimSpace(b)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		*o = nil
+		return nil
+	}
+	if trimmed[0] == '{' {
+		var odd Odd
+		if err := json.Unmarshal(trimmed, &odd); err != nil {
+			return err
+		}
+		*o = Odds{odd}
+		return nil
+	}
+	var odds []Odd
+	if err := json.Unmarshal(trimmed, &odds); err != nil {
+		return err
+	}
+	*o = odds
+	return nil
+}
+
 // TeamOdds represents odds information for a specific team in a matchup
 type TeamOdds struct {
 	Favorite  bool    `json:"favorite,omitempty"`
@@ -101,21 +264,149 @@ type TeamOdds struct {
 
 // Game represents a simplified game structure for our workflow
 type Game struct {
-	ID           string
+	ID           string // ESPN competition ID
+	EventID      string // ESPN event ID - usually the same as the competition ID, but not guaranteed
+	GameURL      string // ESPN's page for the game
 	Sport		string
 	League		string
 	HomeTeam     Team
 	AwayTeam     Team
+	NeutralSite  bool // Neither team is at home (bowl games, tournaments) - HomeTeam/AwayTeam are just ESPN's listing order
 	StartTime    time.Time
 	CurrentScore map[string]string // team ID -> score
+	LineScores   map[string][]int  // team ID -> points by period, 1st period first. Missing until ESPN sends linescores
 	Status       string
 	APIRoot      string // Base URL for the sport/league, e.g. "https://site.api.espn.com/apis/site/v2/sports/football/college-football"
+	Group        string // ESPN group (conference) the game was found under, empty for the general scoreboard
 	Odds         string
 	UnderdogWinning bool
 	TVNetwork	string
 	CurrentPeriod		string
 	NumberOfPeriods int
 	DisplayClock string
+	StatusDetail string // ESPN's status line (detail/shortDetail), preferred over building one from CurrentPeriod/DisplayClock
+	PollInterval time.Duration // Time between score checks, 0 = default of 5 minutes. Changed while running with SetPollIntervalUpdate.
+	MinNotifyInterval time.Duration // Minimum time between non-critical (score_change) notifications, 0 = no throttling
+	LastNotified time.Time // When notifications were last sent - kept on the game so it carries over with the workflow input
+	ActivityTimeouts ActivityTimeouts
+	ActivityRetry ActivityRetry
+	RecordResult bool // Send a GameResult to RESULTS_WEBHOOK_URL when the workflow ends
+	NotificationTypes []string // NOTIFICATION_TYPES as of when the game was started - GameWorkflow doesn't read the worker's config
+	NotificationChannels []string // NOTIFICATION_CHANNELS as of when the game was started
+	FocusTeams []string // Only send score/underdog notifications if one of these teams is playing, empty = all games
+	BatchNotifications bool // Hold notifications for one extra poll and send everything from both polls as one message
+	WinProbabilityThreshold float64 // win_probability alerts fire when a team's chance of winning crosses this (0-1, default 0.5)
+	UnderdogCooldownPeriods int // Periods that have to pass between underdog alerts, 0 = default of 1 (once per period), negative = no cooldown
+	LastUnderdogPeriod int // Period the last underdog alert went out in, 0 = none yet
+	ComebackMargin int // comeback alerts need a team to have trailed by at least this much, 0 = default of 14
+	MaxDeficit map[string]int // team ID -> biggest deficit so far, for comeback alerts
+	StartImmediately bool // start polling right away instead of waiting for StartTime, for when ESPN's start time is off
+	MinScoreDelta int // score_change only fires once the scores have moved this many points (combined) since the last one, 0 = every change
+	LastNotifiedScore map[string]string // team ID -> score as of the last score_change notification, for MinScoreDelta
+	FinalConfirmPolls int // polls in a row the game has to be final before the final notification and the end of monitoring, 0 = default of 2
+	FavoriteTrailingFromPeriod int // favorite_trailing alerts start in this period, 0 = the start of the second half
+	FavoriteTrailingNotified bool // favorite_trailing only fires once per game
+	LastScoringPlayID string // ID of the newest scoring play seen, for scoring_play alerts
+	ReminderLead time.Duration // Send a "starts in" reminder this long before StartTime, 0 = no reminder
+	Timezone string // IANA zone (e.g. "America/New_York") the reminder gives the start time in, empty = UTC
+	NotificationHistory []SentNotification // Everything sent so far, oldest first, for the notificationHistory query - kept on the game so it carries over with the workflow input
+	DigestWorkflowID string // Set for digest collections: notifications only go into NotificationHistory, and the game reports to this DigestWorkflow when it's over
+}
+
+// SentNotification is one entry in a game's notification history
+type SentNotification struct {
+	Title    string    `json:"title"`
+	Message  string    `json:"message"`
+	SentAt   time.Time `json:"sentAt"`
+	Channels []string  `json:"channels"` // The channels it was delivered to, empty if it was held for a digest
+}
+
+// ScoreFor returns team's score, or "" if there isn't one. CurrentScore is keyed by team ID, but falls back to the
+// abbreviation in case ESPN's keys don't line up with the teams the game was built with.
+func (g Game) ScoreFor(team Team) string {
+	if score, ok := g.CurrentScore[team.ID]; ok && team.ID != "" {
+		return score
+	}
+	if team.Abbreviation != "" {
+		return g.CurrentScore[team.Abbreviation]
+	}
+	return ""
+}
+
+// ScoreChange is how one team's score moved between two looks at a game
+type ScoreChange struct {
+	From  string `json:"from"`  // "" if the team had no score before
+	To    string `json:"to"`
+	Delta int    `json:"delta"` // Points scored, negative for a correction. 0 if To isn't a number; a From that isn't counts as 0
+}
+
+// ScoreDiff returns the teams whose score in CurrentScore differs from prev's, keyed by team ID. A team that's new in
+// CurrentScore counts as changed; a team that's dropped out of it doesn't. No changes gives an empty map.
+func (g Game) ScoreDiff(prev Game) map[string]ScoreChange {
+	changes := make(map[string]ScoreChange)
+	for teamID, score := range g.CurrentScore {
+		previous, exists := prev.CurrentScore[teamID]
+		if exists && previous == score {
+			continue
+		}
+		change := ScoreChange{From: previous, To: score}
+		if current, err := strconv.Atoi(score); err == nil {
+			before, _ := strconv.Atoi(previous)
+			change.Delta = current - before
+		}
+		changes[teamID] = change
+	}
+	return changes
+}
+
+// ESPN game states, from a competition's status.type.state
+const (
+	GameStatePre  = "pre"
+	GameStateIn   = "in"
+	GameStatePost = "post"
+)
+
+// gameState normalizes Status to one of the GameState* values. Status is normally ESPN's state, but its status
+// type names (e.g. "STATUS_FINAL") and plain words like "final" are understood too. Anything else comes back as is.
+func gameState(status string) string {
+	switch strings.ToLower(strings.TrimSpace(status)) {
+	case "pre", "scheduled", "status_scheduled":
+		return GameStatePre
+	case "in", "in_progress", "live", "status_in_progress", "status_halftime", "status_end_period":
+		return GameStateIn
+	case "post", "final", "completed", "status_final", "status_full_time":
+		return GameStatePost
+	}
+	return status
+}
+
+// IsPregame reports whether the game hasn't started yet
+func (g Game) IsPregame() bool {
+	return gameState(g.Status) == GameStatePre
+}
+
+// IsLive reports whether the game is underway, including breaks like halftime
+func (g Game) IsLive() bool {
+	return gameState(g.Status) == GameStateIn
+}
+
+// IsFinal reports whether the game is over
+func (g Game) IsFinal() bool {
+	return gameState(g.Status) == GameStatePost
+}
+
+// GameResult is the final result of a game, archived by RecordGameResultActivity
+type GameResult struct {
+	GameID    string    `json:"gameId"`
+	Sport     string    `json:"sport"`
+	League    string    `json:"league"`
+	HomeTeam  string    `json:"homeTeam"`
+	AwayTeam  string    `json:"awayTeam"`
+	HomeScore string    `json:"homeScore"`
+	AwayScore string    `json:"awayScore"`
+	StartTime time.Time `json:"startTime"`
+	MonitorStart time.Time `json:"monitorStart"` // When GameWorkflow started watching the game - later than StartTime if it was tracked late
+	MonitorEnd   time.Time `json:"monitorEnd"`   // When GameWorkflow stopped watching it
 }
 
 // ScoreUpdate represents a score change notification
@@ -136,16 +427,89 @@ type ScoreUpdate struct {
 type TrackingRequest struct {
 	Sport       string   `json:"sport"`
 	League      string   `json:"league"`
-	Teams       []string `json:"teams"`
+	Teams       []string `json:"teams"`             // ESPN team IDs, or names/abbreviations like "Michigan" or "MICH"
+	Watchlist   string   `json:"watchlist,omitempty"` // Name of a saved watchlist (POST /api/watchlists) whose teams are tracked too
+	GameIDs     []string `json:"gameIds"`           // ESPN event IDs to track, found on the general scoreboard like Teams
 	Conferences []string `json:"conferences"`
+	RankedOnly  bool     `json:"rankedOnly"`        // Only track games with a ranked team in them
+	BothRanked  bool     `json:"bothRanked"`        // With RankedOnly, require both teams to be ranked
+	TVOnly      bool     `json:"tvOnly"`            // Only track games ESPN lists a TV broadcast for
+	MinNotifyInterval Duration `json:"minNotifyInterval"` // Throttle score_change notifications per game, 0 = off
+	PollInterval  Duration      `json:"pollInterval"`  // Re-check ESPN for new games this often, 0 = collect once and complete
+	MaxEmptyPolls int           `json:"maxEmptyPolls"` // With PollInterval, stop after this many fetches in a row find no games (default 3)
+	EmptyPolls    int           `json:"emptyPolls,omitempty"` // Consecutive empty fetches so far, carried across Continue-As-New
+	ActivityTimeouts ActivityTimeouts `json:"activityTimeouts,omitempty"` // Per-activity StartToClose timeouts, defaults when unset
+	ActivityRetry ActivityRetry `json:"activityRetry,omitempty"` // Retry attempts and backoff, defaults when unset
+	MaxGames      int           `json:"maxGames"`      // Schedule at most this many games over the whole collection, earliest first (default 100)
+	FocusTeams    []string      `json:"focusTeams"`    // Team IDs/names - score and underdog alerts only for games with one of these teams, other alerts still fire
+	BatchNotifications bool     `json:"batchNotifications"` // Combine notifications from adjacent polls into one message per channel
+	WinProbabilityThreshold float64 `json:"winProbabilityThreshold"` // For win_probability alerts, 0-1 (default 0.5)
+	UnderdogCooldownPeriods int     `json:"underdogCooldownPeriods"` // Periods between underdog alerts (default 1, negative = no cooldown)
+	ComebackMargin int              `json:"comebackMargin"` // Deficit a team has to come back from for a comeback alert (default 14)
+	StartImmediately bool           `json:"startImmediately"` // Poll games right away instead of waiting for ESPN's start time
+	MinScoreDelta int               `json:"minScoreDelta"` // Combined points the score has to move before another score_change alert, 0 = every change
+	FinalConfirmPolls int           `json:"finalConfirmPolls"` // Polls in a row a game has to be final before it counts as over, 0 = default of 2
+	FavoriteTrailingFromPeriod int  `json:"favoriteTrailingFromPeriod"` // Period favorite_trailing alerts start in, 0 = the start of the second half
+	ReminderLead Duration           `json:"reminderLead"` // Send a reminder this long before each game starts, 0 = no reminder
+	Timezone string                 `json:"timezone"` // IANA zone for start times in reminders, e.g. "America/New_York" (default UTC)
+	Digest bool                     `json:"digest"` // No live notifications - one summary of every game once they're all over
+	DigestStarted bool              `json:"digestStarted,omitempty"` // The collection's DigestWorkflow is running, carried across Continue-As-New
+	ScheduledGames []string         `json:"scheduledGames,omitempty"` // GameWorkflow IDs the collection has started, carried across Continue-As-New
+}
+
+// CollectionResult is what CollectGamesWorkflow returns
+type CollectionResult struct {
+	TotalGames int    // Games fetched, summed over the polls in this run
+	ScheduledGames int // Games newly scheduled in this run - ones found again by a later poll aren't counted twice
+	Polls      int    // Polls made in this run
+	StopReason string // Why the collection stopped, see CollectionStop*
 }
 
+const (
+	CollectionStopSinglePass = "single_pass"     // PollInterval not set, collected once
+	CollectionStopNoGames    = "max_empty_polls" // MaxEmptyPolls fetches in a row found no games
+)
+
 // Notification represents a notification to be sent
 type Notification struct {
-	Title   string
-	Message string
+	Title    string
+	Message  string
+	Priority string // One of the Priority* values - channels that support priorities map it to their own, the rest ignore it
+	ScoreCard *ScoreCard // Set for game notifications, for channels that can render more than plain text
+}
+
+// FormatFor renders the title and message in a channel's own markup - the title in bold where the channel has it.
+// Channels without any (the logger, Home Assistant, PagerDuty) get them unchanged.
+func (n Notification) FormatFor(channel string) (title string, body string) {
+	switch channel {
+	case "slack":
+		// Slack's mrkdwn treats &, <, and > as control characters
+		escape := strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+		return "*" + escape.Replace(n.Title) + "*", escape.Replace(n.Message)
+	case "discord":
+		return "**" + n.Title + "**", n.Message
+	default:
+		return n.Title, n.Message
+	}
 }
 
+// ScoreCard is the structured version of a game notification
+type ScoreCard struct {
+	HomeTeam  string
+	AwayTeam  string
+	Score     string // e.g. "MICH 21 - OSU 14"
+	Period    string // e.g. "Q3, 12:34 left"
+	TVNetwork string
+	GameURL   string
+}
+
+// Notification priorities
+const (
+	PriorityLow    = "low"
+	PriorityNormal = "normal"
+	PriorityHigh   = "high"
+)
+
 type SendNotifications struct {
 	Channel string // e.g. "slack", "hass", etc.
 	NotificationList []Notification
@@ -121,7 +121,9 @@ func TestESPNResponse_UnmarshalJSON(t *testing.T) {
 								"name": "In Progress",
 								"state": "in",
 								"completed": false,
-								"description": "In Progress"
+								"description": "In Progress",
+								"detail": "0:00 - 1st Quarter",
+								"shortDetail": "0:00 - 1st"
 							}
 						}
 					}
@@ -180,6 +182,211 @@ func TestESPNResponse_UnmarshalJSON(t *testing.T) {
 	assert.False(t, odds.HomeTeamOdds.Underdog)
 	assert.False(t, odds.AwayTeamOdds.Favorite)
 	assert.True(t, odds.AwayTeamOdds.Underdog)
+
+	assert.Equal(t, "0:00 - 1st Quarter", competition.Status.Type.Detail)
+	assert.Equal(t, "0:00 - 1st", competition.Status.Type.ShortDetail)
+}
+
+func TestCompetition_UnmarshalOdds(t *testing.T) {
+	tests := []struct {
+		name         string
+		odds         string
+		expectedLen  int
+		expectedOdds Odd
+	}{
+		{
+			name:         "array",
+			odds:         `[{"details": "MICH -7.5", "overUnder": 45.5, "homeTeamOdds": {"favorite": true}, "awayTeamOdds": {"underdog": true}}]`,
+			expectedLen:  1,
+			expectedOdds: Odd{Details: "MICH -7.5", OverUnder: 45.5, HomeTeamOdds: &TeamOdds{Favorite: true}, AwayTeamOdds: &TeamOdds{Underdog: true}},
+		},
+		{
+			name:         "single object",
+			odds:         `{"details": "OSU -3", "overUnder": 48, "homeTeamOdds": {"underdog": true}, "awayTeamOdds": {"favorite": true}}`,
+			expectedLen:  1,
+			expectedOdds: Odd{Details: "OSU -3", OverUnder: 48, HomeTeamOdds: &TeamOdds{Underdog: true}, AwayTeamOdds: &TeamOdds{Favorite: true}},
+		},
+		{name: "empty array", odds: `[]`},
+		{name: "null", odds: `null`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var comp Competition
+			require.NoError(t, json.Unmarshal([]byte(`{"id": "401520281", "odds": `+tt.odds+`}`), &comp))
+			require.Len(t, comp.Odds, tt.expectedLen)
+			if tt.expectedLen > 0 {
+				assert.Equal(t, tt.expectedOdds, comp.Odds[0])
+			}
+		})
+	}
+}
+
+func TestBuildGame_OddsObject(t *testing.T) {
+	// The favorite/underdog flags still make it onto the teams when ESPN sends the odds as an object
+	var comp Competition
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "401520281",
+		"competitors": [
+			{"team": {"id": "130"}, "homeAway": "home"},
+			{"team": {"id": "194"}, "homeAway": "away"}
+		],
+		"odds": {"details": "OSU -3", "homeTeamOdds": {"underdog": true}, "awayTeamOdds": {"favorite": true}}
+	}`), &comp))
+
+	game := BuildGame(comp.ID, comp, comp.Competitors[0], comp.Competitors[1], "", TrackingRequest{})
+	assert.Equal(t, "OSU -3", game.Odds)
+	assert.True(t, game.HomeTeam.Underdog)
+	assert.True(t, game.AwayTeam.Favorite)
+}
+
+func TestCompetitor_UnmarshalRecords(t *testing.T) {
+	jsonData := `{
+		"id": "130",
+		"team": {"id": "130", "displayName": "Michigan Wolverines"},
+		"score": "0",
+		"homeAway": "home",
+		"records": [
+			{"name": "overall", "abbreviation": "Game", "type": "total", "summary": "5-1"},
+			{"name": "Home", "type": "home", "summary": "3-0"},
+			{"name": "Road", "type": "road", "summary": "2-1"}
+		]
+	}`
+
+	var competitor Competitor
+	require.NoError(t, json.Unmarshal([]byte(jsonData), &competitor))
+
+	require.Len(t, competitor.Records, 3)
+	assert.Equal(t, TeamRecord{Name: "overall", Type: "total", Summary: "5-1"}, competitor.Records[0])
+	assert.Equal(t, "5-1", recordFromCompetitor(competitor))
+	assert.Empty(t, competitor.Team.Record, "the record comes from the competitor, not the team")
+}
+
+func TestCompetitor_UnmarshalLineScores(t *testing.T) {
+	tests := []struct {
+		name     string
+		json     string
+		expected []int
+	}{
+		{
+			name: "by period",
+			json: `{"team": {"id": "130"}, "score": "24", "linescores": [
+				{"value": 7.0, "displayValue": "7", "period": 1},
+				{"value": 3.0, "displayValue": "3", "period": 2},
+				{"value": 14.0, "displayValue": "14", "period": 3}
+			]}`,
+			expected: []int{7, 3, 14},
+		},
+		{
+			name:     "no period numbers",
+			json:     `{"team": {"id": "130"}, "score": "10", "linescores": [{"value": 7.0}, {"value": 3.0}]}`,
+			expected: []int{7, 3},
+		},
+		{
+			name:     "period skipped",
+			json:     `{"team": {"id": "130"}, "score": "10", "linescores": [{"value": 7.0, "period": 1}, {"value": 3.0, "period": 3}]}`,
+			expected: []int{7, 0, 3},
+		},
+		{
+			name: "game hasn't started",
+			json: `{"team": {"id": "130"}, "score": "0"}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var competitor Competitor
+			require.NoError(t, json.Unmarshal([]byte(tt.json), &competitor))
+			assert.Equal(t, tt.expected, lineScoresFromCompetitor(competitor))
+		})
+	}
+}
+
+func TestBuildGame_LineScores(t *testing.T) {
+	var comp Competition
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"id": "401520281",
+		"competitors": [
+			{"team": {"id": "130"}, "homeAway": "home", "score": "21", "linescores": [{"value": 7, "period": 1}, {"value": 14, "period": 2}]},
+			{"team": {"id": "194"}, "homeAway": "away", "score": "3", "linescores": [{"value": 0, "period": 1}, {"value": 3, "period": 2}]}
+		]
+	}`), &comp))
+
+	game := BuildGame(comp.ID, comp, comp.Competitors[0], comp.Competitors[1], "", TrackingRequest{})
+	assert.Equal(t, map[string][]int{"130": {7, 14}, "194": {0, 3}}, game.LineScores)
+}
+
+func TestTeam_UnmarshalLogo(t *testing.T) {
+	tests := []struct {
+		name     string
+		jsonData string
+		expected string
+	}{
+		{
+			name:     "scoreboard logo",
+			jsonData: `{"id": "130", "displayName": "Michigan Wolverines", "logo": "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png"}`,
+			expected: "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png",
+		},
+		{
+			name: "teams endpoint logos",
+			jsonData: `{"id": "130", "displayName": "Michigan Wolverines", "logos": [
+				{"href": "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png", "rel": ["full", "default"]},
+				{"href": "https://a.espncdn.com/i/teamlogos/ncaa/500-dark/130.png", "rel": ["full", "dark"]}
+			]}`,
+			expected: "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png",
+		},
+		{
+			name:     "our own encoding",
+			jsonData: `{"id": "130", "displayName": "Michigan Wolverines", "logoUrl": "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png"}`,
+			expected: "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png",
+		},
+		{
+			name:     "no logo",
+			jsonData: `{"id": "130", "displayName": "Michigan Wolverines"}`,
+			expected: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var team Team
+			require.NoError(t, json.Unmarshal([]byte(tt.jsonData), &team))
+			assert.Equal(t, "130", team.ID)
+			assert.Equal(t, "Michigan Wolverines", team.DisplayName)
+			assert.Equal(t, tt.expected, team.LogoURL)
+		})
+	}
+
+	// The rest of the team survives a round trip through a workflow payload
+	team := Team{ID: "130", DisplayName: "Michigan Wolverines", Underdog: true, Rank: 3, Record: "5-1", LogoURL: "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png"}
+	data, err := json.Marshal(team)
+	require.NoError(t, err)
+	var decoded Team
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, team, decoded)
+}
+
+func TestNotification_FormatFor(t *testing.T) {
+	notification := Notification{Title: "Upset Alert!", Message: "Texas A&M <3 leads Alabama"}
+
+	tests := []struct {
+		channel       string
+		expectedTitle string
+		expectedBody  string
+	}{
+		{"slack", "*Upset Alert!*", "Texas A&amp;M &lt;3 leads Alabama"},
+		{"discord", "**Upset Alert!**", "Texas A&M <3 leads Alabama"},
+		{"logger", "Upset Alert!", "Texas A&M <3 leads Alabama"},
+		{"hass", "Upset Alert!", "Texas A&M <3 leads Alabama"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.channel, func(t *testing.T) {
+			title, body := notification.FormatFor(tt.channel)
+			assert.Equal(t, tt.expectedTitle, title)
+			assert.Equal(t, tt.expectedBody, body)
+		})
+	}
 }
 
 func TestGame_Creation(t *testing.T) {
@@ -217,6 +424,118 @@ func TestGame_Creation(t *testing.T) {
 	assert.True(t, game.AwayTeam.Underdog)
 }
 
+func TestGame_ScoreFor(t *testing.T) {
+	michigan := Team{ID: "130", Abbreviation: "MICH"}
+	ohioState := Team{ID: "194", Abbreviation: "OSU"}
+
+	tests := []struct {
+		name         string
+		currentScore map[string]string
+		team         Team
+		expected     string
+	}{
+		{name: "keyed by ID", currentScore: map[string]string{"130": "21", "194": "14"}, team: michigan, expected: "21"},
+		{name: "keyed by abbreviation", currentScore: map[string]string{"MICH": "21", "OSU": "14"}, team: ohioState, expected: "14"},
+		{name: "ID wins over abbreviation", currentScore: map[string]string{"130": "21", "MICH": "7"}, team: michigan, expected: "21"},
+		{name: "no score", currentScore: map[string]string{"194": "14"}, team: michigan, expected: ""},
+		{name: "nil map", team: michigan, expected: ""},
+		{name: "team without an ID", currentScore: map[string]string{"": "3"}, team: Team{}, expected: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := Game{CurrentScore: tt.currentScore}
+			assert.Equal(t, tt.expected, game.ScoreFor(tt.team))
+		})
+	}
+}
+
+func TestGame_ScoreDiff(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous map[string]string
+		current  map[string]string
+		expected map[string]ScoreChange
+	}{
+		{
+			name:     "no change",
+			previous: map[string]string{"130": "14", "194": "10"},
+			current:  map[string]string{"130": "14", "194": "10"},
+			expected: map[string]ScoreChange{},
+		},
+		{
+			name:     "one team scores",
+			previous: map[string]string{"130": "14", "194": "10"},
+			current:  map[string]string{"130": "21", "194": "10"},
+			expected: map[string]ScoreChange{"130": {From: "14", To: "21", Delta: 7}},
+		},
+		{
+			name:     "both teams score",
+			previous: map[string]string{"130": "14", "194": "10"},
+			current:  map[string]string{"130": "17", "194": "16"},
+			expected: map[string]ScoreChange{"130": {From: "14", To: "17", Delta: 3}, "194": {From: "10", To: "16", Delta: 6}},
+		},
+		{
+			name:     "score correction",
+			previous: map[string]string{"130": "21", "194": "10"},
+			current:  map[string]string{"130": "14", "194": "10"},
+			expected: map[string]ScoreChange{"130": {From: "21", To: "14", Delta: -7}},
+		},
+		{
+			name:     "first score",
+			current:  map[string]string{"130": "0", "194": "3"},
+			expected: map[string]ScoreChange{"130": {To: "0"}, "194": {To: "3", Delta: 3}},
+		},
+		{
+			name:     "score that isn't a number",
+			previous: map[string]string{"130": "7"},
+			current:  map[string]string{"130": "-"},
+			expected: map[string]ScoreChange{"130": {From: "7", To: "-"}},
+		},
+		{
+			name:     "team dropped from the score",
+			previous: map[string]string{"130": "14", "194": "10"},
+			current:  map[string]string{"130": "14"},
+			expected: map[string]ScoreChange{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := Game{CurrentScore: tt.current}
+			assert.Equal(t, tt.expected, game.ScoreDiff(Game{CurrentScore: tt.previous}))
+		})
+	}
+}
+
+func TestGame_StatusHelpers(t *testing.T) {
+	tests := []struct {
+		status  string
+		pregame bool
+		live    bool
+		final   bool
+	}{
+		{status: "pre", pregame: true},
+		{status: "in", live: true},
+		{status: "post", final: true}, // ESPN's actual final state
+		{status: "STATUS_SCHEDULED", pregame: true},
+		{status: "STATUS_HALFTIME", live: true},
+		{status: "STATUS_FINAL", final: true},
+		{status: "Final", final: true},
+		{status: ""},
+		{status: "postponed"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.status, func(t *testing.T) {
+			game := Game{Status: tt.status}
+			assert.Equal(t, tt.pregame, game.IsPregame(), "IsPregame")
+			assert.Equal(t, tt.live, game.IsLive(), "IsLive")
+			assert.Equal(t, tt.final, game.IsFinal(), "IsFinal")
+		})
+	}
+}
+
 func TestScoreUpdate_Creation(t *testing.T) {
 	timestamp := time.Now()
 	update := ScoreUpdate{
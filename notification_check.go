@@ -0,0 +1,107 @@
+package sports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+// ChannelStatus is how one notification channel's setup checked out
+type ChannelStatus struct {
+	Channel string `json:"channel"`
+	OK      bool   `json:"ok"`
+	Error   string `json:"error,omitempty"`
+}
+
+// ValidateNotificationConfig checks each channel's credentials without sending anything: Slack's auth.test for the
+// bot token, and a HEAD request to make sure the Home Assistant webhook, PagerDuty's Events API and the ntfy topic
+// can be reached. Home Assistant answers the same for webhook IDs it doesn't know, so for hass that's all it shows -
+// the server is up, not that the webhook exists.
+// It returns every channel's status, and an error naming the ones that failed.
+func ValidateNotificationConfig(ctx context.Context, channels []string) ([]ChannelStatus, error) {
+	var statuses []ChannelStatus
+	var errs []error
+	for _, channel := range channels {
+		status := ChannelStatus{Channel: channel, OK: true}
+		if err := validateChannel(ctx, channel); err != nil {
+			status.OK = false
+			status.Error = err.Error()
+			errs = append(errs, fmt.Errorf("%s: %w", channel, err))
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, errors.Join(errs...)
+}
+
+func validateChannel(ctx context.Context, channel string) error {
+	cfg := CurrentConfig()
+	switch channel {
+	case "slack":
+		if cfg.SlackBotToken == "" {
+			return fmt.Errorf("SLACK_BOT_TOKEN environment variable is not set")
+		}
+		if cfg.SlackChannelID == "" {
+			return fmt.Errorf("SLACK_CHANNEL_ID environment variable is not set")
+		}
+		var options []slack.Option
+		if slackAPIURL != "" {
+			options = append(options, slack.OptionAPIURL(slackAPIURL))
+		}
+		if _, err := slack.New(cfg.SlackBotToken, options...).AuthTestContext(ctx); err != nil {
+			return fmt.Errorf("Slack auth.test failed: %w", err)
+		}
+		return nil
+	case "hass":
+		if cfg.HassWebhookURL == "" {
+			return fmt.Errorf("HASS_WEBHOOK_URL environment variable is not set")
+		}
+		// Home Assistant answers 200 even for a webhook ID it doesn't have, so this only shows the server is up
+		return checkWebhook(ctx, cfg.HassWebhookURL)
+	case "pagerduty":
+		// The Events API has no way to check a routing key short of triggering an incident, so this only makes
+		// sure there is one and PagerDuty can be reached
+		if cfg.PagerDutyRoutingKey == "" {
+			return fmt.Errorf("PAGERDUTY_ROUTING_KEY environment variable is not set")
+		}
+		return checkWebhook(ctx, pagerDutyEventsURL)
+	case "ntfy":
+		if cfg.NtfyTopic == "" {
+			return fmt.Errorf("NTFY_TOPIC environment variable is not set")
+		}
+		return checkWebhook(ctx, ntfyTopicURL(cfg))
+	case "logger":
+		return nil
+	default:
+		return fmt.Errorf("unknown notification channel (options: %s)", strings.Join(supportedChannels, ","))
+	}
+}
+
+// webhookCheckTimeout is how long checkWebhook waits for an answer, so one unreachable host can't hang the check
+var webhookCheckTimeout = 10 * time.Second
+
+// checkWebhook makes a HEAD request to url. Webhooks generally only take POST, so any answer but an auth error,
+// a 404 or a server error means it's there.
+func checkWebhook(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	client := &http.Client{Timeout: webhookCheckTimeout}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to reach webhook: %w", err)
+	}
+	resp.Body.Close()
+
+	failed := []int{http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound}
+	if slices.Contains(failed, resp.StatusCode) || resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("webhook check failed: %s", resp.Status)
+	}
+	return nil
+}
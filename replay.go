@@ -0,0 +1,15 @@
+package sports
+
+import (
+	"go.temporal.io/sdk/worker"
+)
+
+// ReplayGameWorkflowHistory replays a recorded GameWorkflow history against the current code. An error means the
+// code no longer makes the same commands - the nondeterminism error a worker would hit on an in-flight game after a
+// deploy. Export a history with `temporal workflow show --output json` to replay one.
+func ReplayGameWorkflowHistory(path string) error {
+	replayer := worker.NewWorkflowReplayer()
+	replayer.RegisterWorkflow(GameWorkflow)
+
+	return replayer.ReplayWorkflowHistoryFromJSONFile(nil, path)
+}
@@ -0,0 +1,37 @@
+package sports
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// requireReplays fails the test if the recorded GameWorkflow history at path no longer replays
+func requireReplays(t *testing.T, path string) {
+	t.Helper()
+
+	err := ReplayGameWorkflowHistory(path)
+	require.NoError(t, err, "replaying %s - if this change to GameWorkflow is intended, it needs workflow.GetVersion so games already running keep working", path)
+}
+
+// setConfigForTest sets the worker's config for the rest of the test
+func setConfigForTest(t *testing.T, cfg Config) {
+	previous := config
+	SetConfig(cfg)
+	t.Cleanup(func() { config = previous })
+}
+
+func TestGameWorkflow_ReplayRecordedHistory(t *testing.T) {
+	// A game picked up in the fourth quarter: it polls after the jittered first wait, sends one score update,
+	// polls again with no change and finishes when the five hour window runs out. It was started before games
+	// carried their notification settings, so it only replays with the config it ran with (score_change to the logger).
+	setConfigForTest(t, Config{NotificationTypes: []string{"score_change"}, NotificationChannels: []string{"logger"}})
+	requireReplays(t, "testdata/game_workflow_history.json")
+}
+
+func TestGameWorkflow_ReplayIgnoresWorkerConfig(t *testing.T) {
+	// The same game, started with its notification settings on the game - a worker since restarted with different
+	// ones still replays it
+	setConfigForTest(t, Config{NotificationTypes: []string{"final", "overtime"}, NotificationChannels: []string{"slack", "discord"}})
+	requireReplays(t, "testdata/game_workflow_history_with_settings.json")
+}
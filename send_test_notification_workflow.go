@@ -0,0 +1,27 @@
+package sports
+
+import (
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+// SendTestNotificationWorkflow sends a canned notification to a single channel, so users can check their setup before game day
+func SendTestNotificationWorkflow(ctx workflow.Context, channel string) error {
+	logger := workflow.GetLogger(ctx)
+	logger.Info("Sending test notification", "channel", channel)
+
+	// Only try once - the caller is waiting on the result and wants the provider's error, not a retry loop
+	activityOptions := workflow.ActivityOptions{
+		StartToCloseTimeout: DefaultNotificationTimeout,
+		RetryPolicy: &temporal.RetryPolicy{
+			MaximumAttempts: 1,
+		},
+	}
+	ctx = workflow.WithActivityOptions(ctx, activityOptions)
+
+	sendNotifications := SendNotifications{
+		Channel:          channel,
+		NotificationList: []Notification{NewTestNotification()},
+	}
+	return workflow.ExecuteActivity(ctx, SendNotificationListActivity, sendNotifications).Get(ctx, nil)
+}
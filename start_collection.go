@@ -0,0 +1,45 @@
+package sports
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.temporal.io/sdk/client"
+)
+
+// StartCollection fills in the operator's settings and starts a CollectGamesWorkflow for req. The web server's
+// /api/track and cmd/start both start collections this way, so they get the same activity timeouts, retries and
+// tracking defaults.
+func StartCollection(ctx context.Context, c client.Client, cfg Config, req TrackingRequest) (client.WorkflowRun, error) {
+	if cfg.TaskQueue == "" {
+		return nil, fmt.Errorf("TASK_QUEUE environment variable is not set")
+	}
+
+	// Activity timeouts and retries are operator config, so they come from our env rather than the request
+	activityTimeouts, err := ActivityTimeoutsFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	req.ActivityTimeouts = activityTimeouts
+	activityRetry, err := ActivityRetryFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	req.ActivityRetry = activityRetry
+
+	// Fill in the operator's tracking defaults (config file or env) for anything the request left out
+	cfg.ApplyTrackingDefaults(&req)
+
+	// Create scheduling workflow ID with timestamp
+	options := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("sports-%s", time.Now().Format("20060102-150405")),
+		TaskQueue: cfg.TaskQueue,
+	}
+
+	we, err := c.ExecuteWorkflow(ctx, options, CollectGamesWorkflow, req)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to start workflow: %w", err)
+	}
+	return we, nil
+}
@@ -0,0 +1,52 @@
+package sports
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/mocks"
+)
+
+func TestStartCollection(t *testing.T) {
+	t.Setenv("ACTIVITY_TIMEOUT_GET_GAMES", "3m")
+	t.Setenv("ACTIVITY_RETRY_COLLECT_MAX_ATTEMPTS", "4")
+	cfg := Config{TaskQueue: "sports-tracker", PollInterval: time.Hour}
+
+	var started TrackingRequest
+	run := mocks.NewWorkflowRun(t)
+	c := mocks.NewClient(t)
+	c.On("ExecuteWorkflow", mock.Anything, mock.MatchedBy(func(options client.StartWorkflowOptions) bool {
+		return options.TaskQueue == "sports-tracker"
+	}), mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		started = args.Get(3).(TrackingRequest)
+	}).Return(run, nil).Once()
+
+	we, err := StartCollection(context.Background(), c, cfg, TrackingRequest{Sport: "football", League: "college-football"})
+	require.NoError(t, err)
+	assert.Equal(t, run, we)
+
+	// The operator's timeouts, retries and tracking defaults are filled in
+	assert.Equal(t, ActivityTimeouts{GetGames: 3 * time.Minute}, started.ActivityTimeouts)
+	assert.Equal(t, ActivityRetry{CollectMaximumAttempts: 4}, started.ActivityRetry)
+	assert.Equal(t, Duration(time.Hour), started.PollInterval)
+}
+
+func TestStartCollection_InvalidSettings(t *testing.T) {
+	c := mocks.NewClient(t)
+
+	t.Run("no task queue", func(t *testing.T) {
+		_, err := StartCollection(context.Background(), c, Config{}, TrackingRequest{Sport: "football", League: "nfl"})
+		assert.ErrorContains(t, err, "TASK_QUEUE")
+	})
+
+	t.Run("bad activity timeout", func(t *testing.T) {
+		t.Setenv("ACTIVITY_TIMEOUT_GET_GAMES", "soon")
+		_, err := StartCollection(context.Background(), c, Config{TaskQueue: "sports-tracker"}, TrackingRequest{Sport: "football", League: "nfl"})
+		assert.ErrorContains(t, err, "ACTIVITY_TIMEOUT_GET_GAMES")
+	})
+}
@@ -0,0 +1,46 @@
+package sports
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+)
+
+// runningGamesQuery finds every running GameWorkflow
+const runningGamesQuery = "WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Running'"
+
+// StopAllGames sends StopTrackingSignal to every running GameWorkflow, so they all wrap up normally - an emergency
+// stop that's gentler than cancelling them. A workflow that can't be signalled doesn't stop the rest; their errors
+// come back together. Returns how many were signalled.
+func StopAllGames(ctx context.Context, c client.Client) (int, error) {
+	stopped := 0
+	var errs []error
+	var pageToken []byte
+	for {
+		resp, err := c.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
+			Query:         runningGamesQuery,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			return stopped, errors.Join(append(errs, fmt.Errorf("failed to list game workflows: %w", err))...)
+		}
+
+		for _, execution := range resp.Executions {
+			workflowID := execution.Execution.WorkflowId
+			err := c.SignalWorkflow(ctx, workflowID, execution.Execution.RunId, StopTrackingSignal, nil)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to stop %s: %w", workflowID, err))
+				continue
+			}
+			stopped++
+		}
+
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			return stopped, errors.Join(errs...)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package sports
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/mocks"
+)
+
+func runningGame(gameID string) *workflowpb.WorkflowExecutionInfo {
+	return &workflowpb.WorkflowExecutionInfo{
+		Execution: &commonpb.WorkflowExecution{WorkflowId: GameWorkflowID(gameID), RunId: "run-" + gameID},
+	}
+}
+
+func TestStopAllGames(t *testing.T) {
+	c := mocks.NewClient(t)
+
+	// Two pages of running games
+	c.On("ListWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListWorkflowExecutionsRequest) bool {
+		return req.Query == runningGamesQuery && len(req.NextPageToken) == 0
+	})).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions:    []*workflowpb.WorkflowExecutionInfo{runningGame("1"), runningGame("2")},
+		NextPageToken: []byte("page-2"),
+	}, nil).Once()
+	c.On("ListWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListWorkflowExecutionsRequest) bool {
+		return string(req.NextPageToken) == "page-2"
+	})).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{runningGame("3")},
+	}, nil).Once()
+
+	// Game 2 finished on its own in the meantime
+	c.On("SignalWorkflow", mock.Anything, "game-1", "run-1", StopTrackingSignal, nil).Return(nil).Once()
+	c.On("SignalWorkflow", mock.Anything, "game-2", "run-2", StopTrackingSignal, nil).Return(assert.AnError).Once()
+	c.On("SignalWorkflow", mock.Anything, "game-3", "run-3", StopTrackingSignal, nil).Return(nil).Once()
+
+	stopped, err := StopAllGames(context.Background(), c)
+	assert.Equal(t, 2, stopped)
+	require.Error(t, err)
+	assert.ErrorContains(t, err, "failed to stop game-2")
+	assert.ErrorIs(t, err, assert.AnError)
+}
+
+func TestStopAllGames_ListFailure(t *testing.T) {
+	c := mocks.NewClient(t)
+	c.On("ListWorkflow", mock.Anything, mock.Anything).Return(nil, assert.AnError)
+
+	stopped, err := StopAllGames(context.Background(), c)
+	assert.Equal(t, 0, stopped)
+	assert.ErrorContains(t, err, "failed to list game workflows")
+}
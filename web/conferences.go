@@ -0,0 +1,52 @@
+package web
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// conferencesRefreshInterval is how often the college leagues' conferences are reloaded from ESPN
+const conferencesRefreshInterval = 24 * time.Hour
+
+// hasConferences reports whether a league is split into conferences - the college ones
+func hasConferences(league string) bool {
+	return strings.Contains(league, "college")
+}
+
+// LoadConferences fetches the conferences for every college league in the registry, so GetConferences and
+// conference name resolution are served from memory. A league that fails is logged and left to be fetched the
+// first time it's asked for.
+func (h *Handlers) LoadConferences(ctx context.Context) {
+	for _, sport := range sportsRegistry {
+		for _, league := range sport.Leagues {
+			if !hasConferences(league.Path) {
+				continue
+			}
+			conferences, err := h.espn.RefreshConferences(ctx, sport.Path, league.Path)
+			if err != nil {
+				fmt.Printf("Failed to load conferences for %s/%s: %v\n", sport.Path, league.Path, err)
+				continue
+			}
+			fmt.Printf("Loaded %d conferences for %s/%s\n", len(conferences), sport.Path, league.Path)
+		}
+	}
+}
+
+// RefreshConferences loads the conferences now and then every conferencesRefreshInterval until ctx is done.
+// Run it in its own goroutine so a slow ESPN doesn't hold up startup.
+func (h *Handlers) RefreshConferences(ctx context.Context) {
+	h.LoadConferences(ctx)
+
+	ticker := time.NewTicker(conferencesRefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			h.LoadConferences(ctx)
+		}
+	}
+}
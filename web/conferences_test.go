@@ -0,0 +1,73 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sports "temporal-sports-tracker"
+)
+
+func TestLoadConferences(t *testing.T) {
+	var mu sync.Mutex
+	requested := map[string]int{}
+	espnDown := false
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		requested[r.URL.Path]++
+		if espnDown {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.Write([]byte(`{"groups": [{"groupId": "80", "name": "NCAA Division I-A", "children": [
+			{"groupId": "5", "name": "Big Ten Conference", "shortName": "Big Ten"},
+			{"groupId": "8", "name": "Southeastern Conference", "shortName": "SEC"}
+		]}]}`))
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+	handlers.espn.Retries = 0
+
+	// Every college league is loaded up front, and nothing else
+	handlers.LoadConferences(context.Background())
+	assert.Equal(t, map[string]int{
+		"/basketball/mens-college-basketball/groups":   1,
+		"/basketball/womens-college-basketball/groups": 1,
+		"/football/college-football/groups":            1,
+	}, requested)
+
+	// GetConferences is served from what was loaded - ESPN going down afterwards doesn't matter
+	mu.Lock()
+	espnDown = true
+	mu.Unlock()
+	req := httptest.NewRequest(http.MethodGet, "/api/conferences/football/college-football", nil)
+	w := httptest.NewRecorder()
+	handlers.GetConferences(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var conferences []Conference
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &conferences))
+	assert.Equal(t, []Conference{{ID: "5", Name: "Big Ten"}, {ID: "8", Name: "SEC"}}, conferences)
+	assert.Equal(t, 1, requested["/football/college-football/groups"])
+
+	// So is name resolution
+	groupID, err := handlers.espn.ResolveConference(context.Background(), "football", "college-football", "SEC")
+	require.NoError(t, err)
+	assert.Equal(t, "8", groupID)
+	assert.Equal(t, 1, requested["/football/college-football/groups"])
+}
+
+func TestHasConferences(t *testing.T) {
+	assert.True(t, hasConferences("college-football"))
+	assert.True(t, hasConferences("womens-college-basketball"))
+	assert.False(t, hasConferences("nfl"))
+	assert.False(t, hasConferences("usa.1"))
+}
@@ -0,0 +1,31 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+)
+
+// ConfigResponse is what /api/config returns: the defaults tracking requests and games get. It's built field by
+// field from sports.Config so tokens, webhook URLs and the Temporal API key never go out with it.
+type ConfigResponse struct {
+	NotificationTypes      []string `json:"notificationTypes"`
+	NotificationChannels   []string `json:"notificationChannels"`
+	CollectionPollInterval string   `json:"collectionPollInterval"` // How often a collection re-checks ESPN for new games by default, e.g. "6h0m0s" - "0s" = collect once. Not the per-game score poll.
+}
+
+// GetConfig returns the notification and tracking defaults this server was started with: /api/config
+func (h *Handlers) GetConfig(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	response := ConfigResponse{
+		NotificationTypes:      h.config.NotificationTypes,
+		NotificationChannels:   h.config.NotificationChannels,
+		CollectionPollInterval: h.config.PollInterval.String(),
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
@@ -0,0 +1,79 @@
+package web
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// espnPage is what we can tell from an espn.com team or game URL
+type espnPage struct {
+	Sport  string
+	League string
+	TeamID string // set for team pages
+	GameID string // set for game pages (game, boxscore, recap, ...)
+}
+
+// parseESPNURL pulls the sport, league, and team or game ID out of a URL pasted from espn.com, e.g.
+// https://www.espn.com/college-football/team/_/id/130/michigan-wolverines or
+// https://www.espn.com/nfl/game/_/gameId/401547353/lions-chiefs (also the older /nfl/game?gameId=401547353 form)
+func parseESPNURL(raw string) (espnPage, error) {
+	var page espnPage
+
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return page, fmt.Errorf("not a valid URL: %q", raw)
+	}
+	host := strings.ToLower(u.Hostname())
+	if host != "espn.com" && !strings.HasSuffix(host, ".espn.com") {
+		return page, fmt.Errorf("not an ESPN URL: %q", raw)
+	}
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(segments) < 2 {
+		return page, fmt.Errorf("not an ESPN team or game URL: %q", raw)
+	}
+
+	// ESPN's pages are under the league path, which maps back to a sport in our registry
+	sport, ok := findSportForLeague(segments[0])
+	if !ok {
+		return page, fmt.Errorf("unsupported league in ESPN URL: %q", segments[0])
+	}
+	page.Sport = sport
+	page.League = segments[0]
+
+	// The IDs are in /_/{key}/{value} pairs after the page type
+	if segments[1] == "team" {
+		page.TeamID = pathValue(segments[2:], "id")
+		if page.TeamID == "" {
+			return page, fmt.Errorf("no team ID in ESPN URL: %q", raw)
+		}
+		return page, nil
+	}
+
+	page.GameID = pathValue(segments[2:], "gameId")
+	if page.GameID == "" {
+		page.GameID = u.Query().Get("gameId")
+	}
+	if page.GameID == "" {
+		return page, fmt.Errorf("no team or game ID in ESPN URL: %q", raw)
+	}
+	if _, err := strconv.ParseUint(page.GameID, 10, 64); err != nil {
+		return page, fmt.Errorf("invalid game ID in ESPN URL: %q", page.GameID)
+	}
+	return page, nil
+}
+
+// pathValue finds key in ESPN's /_/{key}/{value} path segments and returns its value if it's numeric
+func pathValue(segments []string, key string) string {
+	for i := 0; i+1 < len(segments); i++ {
+		if segments[i] != key {
+			continue
+		}
+		if _, err := strconv.ParseUint(segments[i+1], 10, 64); err == nil {
+			return segments[i+1]
+		}
+	}
+	return ""
+}
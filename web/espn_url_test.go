@@ -0,0 +1,141 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	sports "temporal-sports-tracker"
+)
+
+func TestParseESPNURL(t *testing.T) {
+	tests := []struct {
+		name          string
+		url           string
+		expected      espnPage
+		expectedError bool
+	}{
+		{
+			name:     "team page",
+			url:      "https://www.espn.com/college-football/team/_/id/130/michigan-wolverines",
+			expected: espnPage{Sport: "football", League: "college-football", TeamID: "130"},
+		},
+		{
+			name:     "team page without the slug",
+			url:      "https://espn.com/nba/team/_/id/5",
+			expected: espnPage{Sport: "basketball", League: "nba", TeamID: "5"},
+		},
+		{
+			name:     "game page",
+			url:      "https://www.espn.com/college-football/game/_/gameId/401520281/washington-michigan",
+			expected: espnPage{Sport: "football", League: "college-football", GameID: "401520281"},
+		},
+		{
+			name:     "game page with gameId query param",
+			url:      "https://www.espn.com/nfl/game?gameId=401547353",
+			expected: espnPage{Sport: "football", League: "nfl", GameID: "401547353"},
+		},
+		{
+			name:     "boxscore page",
+			url:      "http://www.espn.com/mens-college-basketball/boxscore/_/gameId/401638645",
+			expected: espnPage{Sport: "basketball", League: "mens-college-basketball", GameID: "401638645"},
+		},
+		{
+			name:          "not ESPN",
+			url:           "https://www.cbssports.com/college-football/teams/MICH/michigan-wolverines/",
+			expectedError: true,
+		},
+		{
+			name:          "lookalike host",
+			url:           "https://notespn.com/nfl/team/_/id/8",
+			expectedError: true,
+		},
+		{
+			name:          "not a URL",
+			url:           "Michigan",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported league",
+			url:           "https://www.espn.com/soccer/team/_/id/360/manchester-united",
+			expectedError: true,
+		},
+		{
+			name:          "no ID",
+			url:           "https://www.espn.com/college-football/scoreboard",
+			expectedError: true,
+		},
+		{
+			name:          "non-numeric game ID",
+			url:           "https://www.espn.com/nfl/game?gameId=abc",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			page, err := parseESPNURL(tt.url)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, page)
+		})
+	}
+}
+
+func TestApplyESPNPage(t *testing.T) {
+	req := sports.TrackingRequest{Sport: "basketball", League: "nba", Teams: []string{"130"}}
+
+	applyESPNPage(&req, espnPage{Sport: "football", League: "college-football", TeamID: "130"})
+	assert.Equal(t, "football", req.Sport)
+	assert.Equal(t, "college-football", req.League)
+	assert.Equal(t, []string{"130"}, req.Teams)
+	assert.Empty(t, req.GameIDs)
+
+	applyESPNPage(&req, espnPage{Sport: "football", League: "college-football", GameID: "401520281"})
+	assert.Equal(t, []string{"130"}, req.Teams)
+	assert.Equal(t, []string{"401520281"}, req.GameIDs)
+}
+
+func TestStartTracking_ESPNURL(t *testing.T) {
+	handlers := NewHandlers(nil) // Demo mode (no Temporal client)
+
+	tests := []struct {
+		name           string
+		url            string
+		expectedStatus int
+	}{
+		{
+			name:           "team URL",
+			url:            "https://www.espn.com/college-football/team/_/id/130/michigan-wolverines",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "game URL",
+			url:            "https://www.espn.com/college-football/game/_/gameId/401520281",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "non-ESPN URL",
+			url:            "https://example.com/college-football/team/_/id/130",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body, _ := json.Marshal(map[string]string{"url": tt.url})
+			req := httptest.NewRequest(http.MethodPost, "/api/track", bytes.NewBuffer(body))
+			w := httptest.NewRecorder()
+
+			handlers.StartTracking(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
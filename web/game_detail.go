@@ -0,0 +1,106 @@
+package web
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	sports "temporal-sports-tracker"
+
+	"go.temporal.io/sdk/temporal"
+)
+
+// GameDetail is one game's live state, straight from ESPN's summary endpoint - no workflow needed
+type GameDetail struct {
+	GameID         string               `json:"gameId"`
+	Sport          string               `json:"sport"`
+	League         string               `json:"league"`
+	HomeTeam       sports.Team          `json:"homeTeam"`
+	AwayTeam       sports.Team          `json:"awayTeam"`
+	HomeScore      string               `json:"homeScore"`
+	AwayScore      string               `json:"awayScore"`
+	HomeLineScores []int                `json:"homeLineScores,omitempty"` // Points by period, 1st period first
+	AwayLineScores []int                `json:"awayLineScores,omitempty"`
+	Status         string               `json:"status"` // "pre", "in", or "post"
+	StatusDetail   string               `json:"statusDetail,omitempty"`
+	Period         string               `json:"period"`
+	Clock          string               `json:"clock"`
+	GameURL        string               `json:"gameUrl,omitempty"`
+	ScoringPlays   []sports.ScoringPlay `json:"scoringPlays"` // Oldest first
+}
+
+// GetGameDetail returns a single game's score, status, and scoring plays from ESPN: /api/game/{sport}/{league}/{id}
+func (h *Handlers) GetGameDetail(w http.ResponseWriter, r *http.Request) {
+	defer recoverInternalError(w)
+
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	pathParts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/game/"), "/")
+	if len(pathParts) != 3 || pathParts[0] == "" || pathParts[1] == "" || pathParts[2] == "" {
+		http.Error(w, "Sport, league, and game ID required", http.StatusBadRequest)
+		return
+	}
+	sport, league, gameID := pathParts[0], pathParts[1], pathParts[2]
+	if !knownLeague(sport, league) {
+		http.Error(w, fmt.Sprintf("Unsupported league: %s/%s", sport, league), http.StatusBadRequest)
+		return
+	}
+	// ESPN's game IDs are numeric - anything else would end up in ESPN's query string
+	if _, err := strconv.ParseUint(gameID, 10, 64); err != nil {
+		http.Error(w, fmt.Sprintf("Invalid game ID: %q", gameID), http.StatusBadRequest)
+		return
+	}
+
+	apiRoot := h.espn.APIRoot(sport, league)
+	url := fmt.Sprintf("%s/summary?event=%s", apiRoot, gameID)
+
+	var summary sports.SummaryResponse
+	if err := h.espn.GetJSON(r.Context(), url, &summary); err != nil {
+		// ESPN answers an unknown game ID with a 4xx
+		var appErr *temporal.ApplicationError
+		if errors.As(err, &appErr) && appErr.Type() == sports.ESPNRequestErrorType {
+			http.Error(w, fmt.Sprintf("Game not found: %s", gameID), http.StatusNotFound)
+			return
+		}
+		fmt.Printf("Failed to fetch game %s (%s/%s) from ESPN: %v\n", gameID, sport, league, err)
+		http.Error(w, "Failed to fetch game from ESPN", http.StatusBadGateway)
+		return
+	}
+	if len(summary.Header.Competitions) == 0 || len(summary.Header.Competitions[0].Competitors) < 2 {
+		http.Error(w, fmt.Sprintf("Game not found: %s", gameID), http.StatusNotFound)
+		return
+	}
+
+	comp := summary.Header.Competitions[0]
+	game := sports.BuildGame(summary.Header.ID, comp, comp.Competitors[0], comp.Competitors[1], apiRoot,
+		sports.TrackingRequest{Sport: sport, League: league})
+
+	detail := GameDetail{
+		GameID:         game.ID,
+		Sport:          sport,
+		League:         league,
+		HomeTeam:       game.HomeTeam,
+		AwayTeam:       game.AwayTeam,
+		HomeScore:      game.ScoreFor(game.HomeTeam),
+		AwayScore:      game.ScoreFor(game.AwayTeam),
+		HomeLineScores: game.LineScores[game.HomeTeam.ID],
+		AwayLineScores: game.LineScores[game.AwayTeam.ID],
+		Status:         game.Status,
+		StatusDetail:   game.StatusDetail,
+		Period:         game.CurrentPeriod,
+		Clock:          game.DisplayClock,
+		GameURL:        game.GameURL,
+		ScoringPlays:   summary.ScoringPlays,
+	}
+	if detail.ScoringPlays == nil {
+		detail.ScoringPlays = []sports.ScoringPlay{}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(detail)
+}
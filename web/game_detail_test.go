@@ -0,0 +1,124 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sports "temporal-sports-tracker"
+)
+
+const gameSummaryJSON = `{
+	"header": {
+		"id": "401520281",
+		"competitions": [{
+			"id": "401520281",
+			"date": "2024-11-30T17:00Z",
+			"status": {
+				"displayClock": "8:12",
+				"period": 4,
+				"type": {"name": "STATUS_IN_PROGRESS", "state": "in", "completed": false, "detail": "8:12 - 4th Quarter"}
+			},
+			"competitors": [
+				{"id": "194", "homeAway": "away", "score": "10", "team": {"id": "194", "displayName": "Ohio State Buckeyes", "abbreviation": "OSU"}},
+				{"id": "130", "homeAway": "home", "score": "13", "team": {"id": "130", "displayName": "Michigan Wolverines", "abbreviation": "MICH", "logo": "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png"},
+					"linescores": [{"value": 7, "period": 1}, {"value": 0, "period": 2}, {"value": 3, "period": 3}, {"value": 3, "period": 4}]}
+			]
+		}]
+	},
+	"scoringPlays": [
+		{"id": "p1", "type": {"text": "Touchdown"}, "text": "Blake Corum 12 Yd Run (James Turner Kick)"},
+		{"id": "p2", "type": {"text": "Field Goal"}, "text": "Jayden Fielding 38 Yd Field Goal"}
+	]
+}`
+
+func TestGetGameDetail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/football/college-football/summary", r.URL.Path)
+		if r.URL.Query().Get("event") != "401520281" {
+			// What ESPN does with a game ID it doesn't know
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"code": 400, "message": "Failed to get event summary"}`))
+			return
+		}
+		w.Write([]byte(gameSummaryJSON))
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	t.Run("live game", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/game/football/college-football/401520281", nil)
+		w := httptest.NewRecorder()
+		handlers.GetGameDetail(w, req)
+		require.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+		var detail GameDetail
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &detail))
+		assert.Equal(t, "401520281", detail.GameID)
+		assert.Equal(t, "Michigan Wolverines", detail.HomeTeam.DisplayName)
+		assert.Equal(t, "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png", detail.HomeTeam.LogoURL)
+		assert.Equal(t, "Ohio State Buckeyes", detail.AwayTeam.DisplayName)
+		assert.Equal(t, "13", detail.HomeScore)
+		assert.Equal(t, "10", detail.AwayScore)
+		assert.Equal(t, []int{7, 0, 3, 3}, detail.HomeLineScores)
+		assert.Nil(t, detail.AwayLineScores, "ESPN didn't send the away team's")
+		assert.Equal(t, "in", detail.Status)
+		assert.Equal(t, "8:12 - 4th Quarter", detail.StatusDetail)
+		assert.Equal(t, "4", detail.Period)
+		assert.Equal(t, "8:12", detail.Clock)
+		assert.Equal(t, "https://www.espn.com/college-football/game/_/gameId/401520281", detail.GameURL)
+		require.Len(t, detail.ScoringPlays, 2)
+		assert.Equal(t, "Jayden Fielding 38 Yd Field Goal", detail.ScoringPlays[1].Text)
+	})
+
+	t.Run("unknown game", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/api/game/football/college-football/999", nil)
+		w := httptest.NewRecorder()
+		handlers.GetGameDetail(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code)
+		assert.Contains(t, w.Body.String(), "Game not found: 999")
+	})
+}
+
+func TestGetGameDetail_BadRequests(t *testing.T) {
+	// None of these should get as far as ESPN
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("unexpected ESPN request: %s", r.URL)
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"missing game ID", http.MethodGet, "/api/game/football/college-football", http.StatusBadRequest, "Sport, league, and game ID required"},
+		{"empty game ID", http.MethodGet, "/api/game/football/college-football/", http.StatusBadRequest, "Sport, league, and game ID required"},
+		{"wrong method", http.MethodPost, "/api/game/football/college-football/401520281", http.StatusMethodNotAllowed, "Method not allowed"},
+		{"unknown league", http.MethodGet, "/api/game/football/xfl/401520281", http.StatusBadRequest, "Unsupported league: football/xfl"},
+		{"league under the wrong sport", http.MethodGet, "/api/game/basketball/college-football/401520281", http.StatusBadRequest, "Unsupported league: basketball/college-football"},
+		{"non-numeric game ID", http.MethodGet, "/api/game/football/college-football/michigan", http.StatusBadRequest, `Invalid game ID: "michigan"`},
+		{"extra ESPN query params", http.MethodGet, "/api/game/football/college-football/1&foo=bar", http.StatusBadRequest, `Invalid game ID: "1&foo=bar"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+			handlers.GetGameDetail(w, req)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Contains(t, w.Body.String(), tt.expectedBody)
+		})
+	}
+}
@@ -4,28 +4,46 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
-	"os"
+	"slices"
 	"sort"
 	"strings"
+	"sync"
 	sports "temporal-sports-tracker"
 	"time"
 
+	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/client"
 )
 
 type Handlers struct {
 	temporalClient client.Client
+	config         sports.Config
+	espn           *sports.ESPNClient
+	teams          *teamsCache
+	watchlists     *watchlistStore
 }
 
 func NewHandlers(temporalClient client.Client) *Handlers {
 	return &Handlers{
 		temporalClient: temporalClient,
+		config:         sports.CurrentConfig(),
+		espn:           sports.DefaultESPNClient,
+		teams:          newTeamsCache(teamsCacheTTL),
+		watchlists:     newWatchlistStore(),
 	}
 }
 
+// Validate checks the handlers have what they need to start workflows, so a bad config fails at startup
+// instead of as a 500 on the first tracking request. Demo mode (no Temporal client) needs nothing.
+func (h *Handlers) Validate() error {
+	if h.temporalClient != nil && h.config.TaskQueue == "" {
+		return fmt.Errorf("TASK_QUEUE environment variable is not set")
+	}
+	return nil
+}
+
 // Sport represents a sport available in ESPN API
 type Sport struct {
 	ID   string `json:"id"`
@@ -40,10 +58,12 @@ type League struct {
 	Path string `json:"path"`
 }
 
-// Conference represents a conference within a league
+// Conference represents a conference within a league, or one of a conference's divisions
 type Conference struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID        string       `json:"id"`
+	Name      string       `json:"name"`
+	Parent    string       `json:"parent,omitempty"`    // For a division, the ID of its conference
+	Divisions []Conference `json:"divisions,omitempty"` // Any divisions the conference is split into, e.g. SEC East and West
 }
 
 // GameWorkflow represents running workflow information
@@ -56,8 +76,39 @@ type GameWorkflow struct {
 	HomeScore string    `json:"homeScore"`
 	AwayTeam  string    `json:"awayTeam"`
 	AwayScore string    `json:"awayScore"`
+	HomeTeamLogo string `json:"homeTeamLogo,omitempty"`
+	AwayTeamLogo string `json:"awayTeamLogo,omitempty"`
 	StartTime time.Time `json:"startTime"`
 	GameID   string    `json:"gameId"`
+	GameURL  string    `json:"gameUrl,omitempty"` // ESPN's page for the game
+	// Only filled in with ?detailed=true
+	ExecutionStartTime *time.Time `json:"executionStartTime,omitempty"`
+	HistoryLength      int64      `json:"historyLength,omitempty"`
+}
+
+// defaultCompletedSince is how far back /api/workflows/completed looks without ?since=
+const defaultCompletedSince = 24 * time.Hour
+
+// CompletedGameWorkflow is a finished GameWorkflow and its result
+type CompletedGameWorkflow struct {
+	WorkflowID  string    `json:"workflowId"`
+	RunID       string    `json:"runId"`
+	WorkflowURL string    `json:"workflowUrl,omitempty"`
+	GameID      string    `json:"gameId"`
+	CloseTime   time.Time `json:"closeTime"`
+	Result      string    `json:"result,omitempty"` // e.g. "Final score: MICH 13 - OSU 10"
+}
+
+// GameScore is the score-only view of a tracked game, used by the scoreboard endpoint
+type GameScore struct {
+	GameID    string    `json:"gameId"`
+	HomeTeam  string    `json:"homeTeam"`
+	HomeScore string    `json:"homeScore"`
+	AwayTeam  string    `json:"awayTeam"`
+	AwayScore string    `json:"awayScore"`
+	Period    string    `json:"period"`
+	Clock     string    `json:"clock"`
+	StartTime time.Time `json:"startTime"`
 }
 
 // GetSports returns available sports from ESPN API
@@ -67,13 +118,9 @@ func (h *Handlers) GetSports(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Predefined list of supported ESPN sports
-	sports := []Sport{
-		{ID: "baseball", Name: "Baseball", Path: "baseball"},
-		{ID: "basketball", Name: "Basketball", Path: "basketball"},
-		{ID: "football", Name: "Football", Path: "football"},
-		{ID: "hockey", Name: "Hockey", Path: "hockey"},
-		{ID: "soccer", Name: "Soccer", Path: "soccer"},
+	var sports []Sport
+	for _, sport := range sportsRegistry {
+		sports = append(sports, sport.Sport)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
@@ -89,48 +136,35 @@ func (h *Handlers) GetLeagues(w http.ResponseWriter, r *http.Request) {
 
 	sportPath := strings.TrimPrefix(r.URL.Path, "/api/leagues/")
 	if sportPath == "" {
-		http.Error(w, "Sport required", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, "Sport required")
 		return
 	}
 
-	var leagues []League
-	switch sportPath {
-	case "football":
-		leagues = []League{
-			{ID: "nfl", Name: "NFL", Path: "nfl"},
-			{ID: "college-football", Name: "College Football", Path: "college-football"},
-		}
-	case "basketball":
-		leagues = []League{
-			{ID: "nba", Name: "NBA", Path: "nba"},
-			{ID: "mens-college-basketball", Name: "Men's College Basketball", Path: "mens-college-basketball"},
-			{ID: "womens-college-basketball", Name: "Women's College Basketball", Path: "womens-college-basketball"},
-		}
-	case "baseball":
-		leagues = []League{
-			{ID: "mlb", Name: "MLB", Path: "mlb"},
-		}
-	case "hockey":
-		leagues = []League{
-			{ID: "nhl", Name: "NHL", Path: "nhl"},
-		}
-	case "soccer":
-		leagues = []League{
-			{ID: "usa.1", Name: "MLS", Path: "usa.1"},
-			{ID: "eng.1", Name: "English Premier League", Path: "eng.1"},
-			{ID: "uefa.champions", Name: "UEFA Champions League", Path: "uefa.champions"},
-		}
-	default:
-		http.Error(w, "Unsupported sport", http.StatusBadRequest)
+	sport, ok := findSport(sportPath)
+	if !ok {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Unsupported sport: %s", sportPath))
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(sport.Leagues)
+}
+
+// GetAllLeagues returns every sport with its leagues in one response, so the UI can build its menus without a request per sport
+func (h *Handlers) GetAllLeagues(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
 	}
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(leagues)
+	json.NewEncoder(w).Encode(sportsRegistry)
 }
 
 // GetTeams fetches teams for a specific sport/league from ESPN API
 func (h *Handlers) GetTeams(w http.ResponseWriter, r *http.Request) {
+	defer recoverInternalError(w)
+
 	if r.Method != http.MethodGet {
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 		return
@@ -145,24 +179,19 @@ func (h *Handlers) GetTeams(w http.ResponseWriter, r *http.Request) {
 	sport := pathParts[0]
 	league := pathParts[1]
 
-	url := fmt.Sprintf("https://site.api.espn.com/apis/site/v2/sports/%s/%s/scoreboard", sport, league)
-	
-	resp, err := http.Get(url)
-	if err != nil {
-		http.Error(w, "Failed to fetch teams", http.StatusInternalServerError)
+	if teams, ok := h.teams.get(sport, league); ok {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(teams)
 		return
 	}
-	defer resp.Body.Close()
 
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		http.Error(w, "Failed to read response", http.StatusInternalServerError)
-		return
-	}
+	url := h.espn.APIRoot(sport, league) + "/scoreboard"
 
 	var espnResp sports.ESPNResponse
-	if err := json.Unmarshal(body, &espnResp); err != nil {
-		http.Error(w, "Failed to parse ESPN response", http.StatusInternalServerError)
+	if err := h.espn.GetJSON(r.Context(), url, &espnResp); err != nil {
+		// ESPN is the one failing here, not us - 502 keeps it distinguishable from our own bugs in monitoring
+		fmt.Printf("Failed to fetch teams from ESPN: %v\n", err)
+		http.Error(w, "Failed to fetch teams from ESPN", http.StatusBadGateway)
 		return
 	}
 
@@ -178,11 +207,30 @@ func (h *Handlers) GetTeams(w http.ResponseWriter, r *http.Request) {
 					DisplayName:  team.DisplayName,
 					Abbreviation: team.Abbreviation,
 					ConferenceId: team.ConferenceId,
+					LogoURL:      team.LogoURL,
 				}
 			}
 		}
 	}
 
+	// On a day with no games the scoreboard has no teams, so fall back to the league's full team list. It's
+	// only a nice-to-have, so if it fails too the (empty) scoreboard answer stands.
+	if len(teamMap) == 0 {
+		var teamsResp sports.TeamsResponse
+		if err := h.espn.GetJSON(r.Context(), h.espn.APIRoot(sport, league)+"/teams?limit=1000", &teamsResp); err != nil {
+			fmt.Printf("Failed to fetch teams for %s/%s from ESPN's teams endpoint: %v\n", sport, league, err)
+		}
+		for _, team := range teamsResp.AllTeams() {
+			teamMap[team.ID] = sports.Team{
+				ID:           team.ID,
+				Name:         team.Name,
+				DisplayName:  team.DisplayName,
+				Abbreviation: team.Abbreviation,
+				LogoURL:      team.LogoURL,
+			}
+		}
+	}
+
 	// Convert map to slice
 	var teams []sports.Team
 	for _, team := range teamMap {
@@ -193,11 +241,32 @@ func (h *Handlers) GetTeams(w http.ResponseWriter, r *http.Request) {
 	sort.Slice(teams, func(i, j int) bool {
 		return teams[i].DisplayName < teams[j].DisplayName
 	})
+	h.teams.set(sport, league, teams)
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(teams)
 }
 
+// ErrorResponse is the body of an API error, for the endpoints that answer in JSON either way
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+// writeJSONError responds with status and message as an ErrorResponse
+func writeJSONError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(ErrorResponse{Error: message})
+}
+
+// recoverInternalError turns a panic in a handler into a 500, so our own bugs show up as internal errors rather than dropped connections
+func recoverInternalError(w http.ResponseWriter) {
+	if rec := recover(); rec != nil {
+		fmt.Printf("Internal error handling request: %v\n", rec)
+		http.Error(w, "Internal server error", http.StatusInternalServerError)
+	}
+}
+
 // GetConferences returns available conferences for a sport/league
 func (h *Handlers) GetConferences(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -207,44 +276,53 @@ func (h *Handlers) GetConferences(w http.ResponseWriter, r *http.Request) {
 
 	pathParts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/conferences/"), "/")
 	if len(pathParts) < 2 {
-		http.Error(w, "Sport and league required", http.StatusBadRequest)
+		writeJSONError(w, http.StatusBadRequest, "Sport and league required")
 		return
 	}
 
+	sport := pathParts[0]
 	league := pathParts[1]
 
-	// For now, return predefined conferences for college sports
-	var conferences []Conference
-	if league == "college-football" {
-		conferences = []Conference{
-			{ID: "5", Name: "Big Ten"},
-			{ID: "8", Name: "SEC"},
-			{ID: "1", Name: "ACC"},
-			{ID: "4", Name: "Big 12"},
-			{ID: "151", Name: "American"}, 
-			{ID: "15", Name: "MAC"}, 
-			{ID: "17", Name: "Mountain West"},
-			{ID: "20", Name: "Sun Belt"},
-		}
+	// An empty list means the league has no conferences, so a league we don't know is an error rather than that
+	if !knownLeague(sport, league) {
+		writeJSONError(w, http.StatusBadRequest, fmt.Sprintf("Unsupported league: %s/%s", sport, league))
+		return
+	}
+	conferences := []Conference{}
+	if !hasConferences(league) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(conferences)
+		return
 	}
 
-	if league == "mens-college-basketball" || league == "womens-college-basketball" {
-		conferences = []Conference{
-			{ID: "7", Name: "Big Ten"},
-			{ID: "23", Name: "SEC"},
-			{ID: "2", Name: "ACC"},
-			{ID: "7", Name: "Big 12"},
-			{ID: "62", Name: "American"}, 
-			{ID: "14", Name: "MAC"}, 
-			{ID: "44", Name: "Mountain West"},
-			{ID: "27", Name: "Sun Belt"},
+	// ESPN's groups, loaded at startup by LoadConferences - the same list ResolveConference matches names against.
+	// Once they've been loaded, the last list is served if ESPN can't be reached, so this only fails before then.
+	groups, err := h.espn.Conferences(r.Context(), sport, league)
+	if err != nil {
+		fmt.Printf("Failed to get conferences for %s/%s from ESPN: %v\n", sport, league, err)
+		http.Error(w, "Failed to fetch conferences from ESPN", http.StatusBadGateway)
+		return
+	}
+	for _, group := range groups {
+		conference := Conference{ID: group.GroupID, Name: groupName(group)}
+		for _, division := range group.Children {
+			conference.Divisions = append(conference.Divisions, Conference{ID: division.GroupID, Name: groupName(division), Parent: group.GroupID})
 		}
+		conferences = append(conferences, conference)
 	}
 
 	w.Header().Set("Content-Type", "application/json")
 	json.NewEncoder(w).Encode(conferences)
 }
 
+// groupName is the name the UI shows for an ESPN group - its short name, if it has one
+func groupName(group sports.Group) string {
+	if group.ShortName != "" {
+		return group.ShortName
+	}
+	return group.Name
+}
+
 // StartTracking starts tracking workflows for selected teams/conferences
 func (h *Handlers) StartTracking(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodPost {
@@ -252,13 +330,31 @@ func (h *Handlers) StartTracking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	var req sports.TrackingRequest
-	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+	var body trackingRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		http.Error(w, "Invalid request body", http.StatusBadRequest)
 		return
 	}
+	req := body.TrackingRequest
 
-	// Check if Temporal client is available
+	// A pasted ESPN team or game page fills in the sport, league, and what to track
+	if body.URL != "" {
+		page, err := parseESPNURL(body.URL)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		applyESPNPage(&req, page)
+	}
+
+	// A saved watchlist is expanded to its teams here, so the workflow just sees a list of teams
+	if err := h.applyWatchlist(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	// Demo mode (no Temporal client) doesn't need TASK_QUEUE or any other config, so it's answered before
+	// anything reads it - a missing setting must never turn a demo request into a 500
 	if h.temporalClient == nil {
 		response := map[string]string{
 			"workflowId": "demo-workflow-" + time.Now().Format("20060102-150405"),
@@ -270,23 +366,9 @@ func (h *Handlers) StartTracking(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Create scheduling workflow ID with timestamp
-	workflowID := fmt.Sprintf("sports-%s", time.Now().Format("20060102-150405"))
-
-	TaskQueueName := os.Getenv("TASK_QUEUE")
-	if TaskQueueName == "" {
-		http.Error(w, "TASK_QUEUE environment variable is not set", http.StatusInternalServerError)
-		return
-	}
-
-	options := client.StartWorkflowOptions{
-		ID:        workflowID,
-		TaskQueue: TaskQueueName,
-	}
-	
-	we, err := h.temporalClient.ExecuteWorkflow(context.Background(), options, sports.CollectGamesWorkflow, req)
+	we, err := h.startCollection(r.Context(), req)
 	if err != nil {
-		http.Error(w, fmt.Sprintf("Failed to start workflow: %v", err), http.StatusInternalServerError)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
 
@@ -300,6 +382,35 @@ func (h *Handlers) StartTracking(w http.ResponseWriter, r *http.Request) {
 	json.NewEncoder(w).Encode(response)
 }
 
+// startCollection starts a CollectGamesWorkflow for req with sports.StartCollection. Everything that needs a real
+// Temporal client or config lives here, so StartTracking's demo branch can't reach it.
+func (h *Handlers) startCollection(ctx context.Context, req sports.TrackingRequest) (client.WorkflowRun, error) {
+	// Validate catches this at startup, but don't start a workflow on an empty task queue if it was skipped
+	if err := h.Validate(); err != nil {
+		return nil, err
+	}
+
+	return sports.StartCollection(ctx, h.temporalClient, h.config, req)
+}
+
+// trackingRequestBody is the body of POST /api/track: a TrackingRequest, optionally with an ESPN URL instead of the sport/league/teams
+type trackingRequestBody struct {
+	sports.TrackingRequest
+	URL string `json:"url"` // e.g. https://www.espn.com/college-football/team/_/id/130/michigan-wolverines
+}
+
+// applyESPNPage points req at the team or game from a pasted ESPN URL
+func applyESPNPage(req *sports.TrackingRequest, page espnPage) {
+	req.Sport = page.Sport
+	req.League = page.League
+	if page.TeamID != "" && !slices.Contains(req.Teams, page.TeamID) {
+		req.Teams = append(req.Teams, page.TeamID)
+	}
+	if page.GameID != "" && !slices.Contains(req.GameIDs, page.GameID) {
+		req.GameIDs = append(req.GameIDs, page.GameID)
+	}
+}
+
 // GetWorkflows returns currently running workflows
 func (h *Handlers) GetWorkflows(w http.ResponseWriter, r *http.Request) {
 	if r.Method != http.MethodGet {
@@ -309,6 +420,9 @@ func (h *Handlers) GetWorkflows(w http.ResponseWriter, r *http.Request) {
 
 	var gameWorkflows []GameWorkflow
 
+	// Describing each workflow is an extra RPC per game, so only do it when asked
+	detailed := r.URL.Query().Get("detailed") == "true"
+
 	// Check if Temporal client is available
 	if h.temporalClient == nil {
 		// Return empty list in demo mode
@@ -318,9 +432,14 @@ func (h *Handlers) GetWorkflows(w http.ResponseWriter, r *http.Request) {
 	}
 
 	// List workflows using the Temporal Go SDK
-	// Query for running workflows with game- prefix (GameWorkflows)
+	// Query for running workflows with game- prefix (GameWorkflows), optionally narrowed by the search attributes GameWorkflow sets
+	query, err := buildRunningGamesQuery(r.URL.Query().Get("sport"), r.URL.Query().Get("league"))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
 	listRequest := &workflowservice.ListWorkflowExecutionsRequest{
-		Query: "WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Running'",
+		Query: query,
 	}
 
 	resp, err := h.temporalClient.ListWorkflow(context.Background(), listRequest)
@@ -332,53 +451,308 @@ func (h *Handlers) GetWorkflows(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	// Process the workflow executions
-	for _, execution := range resp.Executions {
-		workflow := GameWorkflow{
-			WorkflowID: execution.Execution.WorkflowId,
-			RunID:      execution.Execution.RunId,
-			Status:     execution.Status.String(),
-		}
-		
-		var tempURL = fmt.Sprintf("/namespaces/%s/workflows/%s/%s", os.Getenv("TEMPORAL_NAMESPACE"), workflow.WorkflowID, workflow.RunID)
+	// Process the workflow executions. The search attributes only narrow the listing down - the scores it shows are
+	// only known to each workflow, so every row is still a gameInfo query (and maybe a describe). Run a few at a time
+	// rather than one after another - each goroutine only writes its own slot, so the order is still the listing's
+	gameWorkflows = make([]GameWorkflow, len(resp.Executions))
+	h.forEachConcurrently(len(resp.Executions), func(i int) {
+		gameWorkflows[i] = h.gameWorkflow(resp.Executions[i], detailed)
+	})
 
-		// Add http or https and UI URL, based on TEMPORAL_HOST
-		if os.Getenv("TEMPORAL_HOST") != "localhost:7233" {
-			workflow.WorkflowURL = fmt.Sprintf("https://cloud.temporal.io%s", tempURL)
-		} else {
-			workflow.WorkflowURL = fmt.Sprintf("http://localhost:8233%s", tempURL)
-		}
+	// Sort workflows by StartTime
+	sort.SliceStable(gameWorkflows, func(i, j int) bool {
+		return gameWorkflows[i].StartTime.Before(gameWorkflows[j].StartTime)
+	})
 
-		// Get the info about the game from the gameInfo query in GameWorkflow
-		var gameInfo sports.Game
-		gameInfoResult, err := h.temporalClient.QueryWorkflow(context.Background(), workflow.WorkflowID, workflow.RunID, "gameInfo")
-		if err != nil {
-			fmt.Printf("Failed to query workflow %s: %v\n", workflow.WorkflowID, err)
-		}
-		err = gameInfoResult.Get(&gameInfo)
-		if err != nil {
-			fmt.Printf("Failed to get query result for workflow %s: %v\n", workflow.WorkflowID, err)
-		}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(gameWorkflows)
+}
+
+// forEachConcurrently calls fn for every index below n, at most GameInfoConcurrency at a time, and waits for them all.
+// fn runs on its own goroutine, so it should only write its own index's slot.
+func (h *Handlers) forEachConcurrently(n int, fn func(i int)) {
+	concurrency := h.config.GameInfoConcurrency
+	if concurrency <= 0 {
+		concurrency = sports.DefaultGameInfoConcurrency
+	}
+	slots := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range n {
+		wg.Add(1)
+		slots <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-slots }()
+			fn(i)
+		}()
+	}
+	wg.Wait()
+}
+
+// gameWorkflow builds the listing for one running GameWorkflow from its gameInfo query
+func (h *Handlers) gameWorkflow(execution *workflowpb.WorkflowExecutionInfo, detailed bool) GameWorkflow {
+	workflow := GameWorkflow{
+		WorkflowID: execution.Execution.WorkflowId,
+		RunID:      execution.Execution.RunId,
+		Status:     execution.Status.String(),
+	}
+
+	workflow.WorkflowURL = h.workflowURL(workflow.WorkflowID, workflow.RunID)
+
+	// Get the info about the game from the gameInfo query in GameWorkflow
+	gameInfo, err := h.queryGameInfo(workflow.WorkflowID, workflow.RunID)
+	if err != nil {
+		// Still list the workflow, just without the game details (e.g. the worker is down and can't answer queries)
+		fmt.Printf("Failed to get game info for workflow %s: %v\n", workflow.WorkflowID, err)
+		workflow.Status = "Unavailable"
+		workflow.GameID = strings.TrimPrefix(workflow.WorkflowID, "game-")
+	} else {
 		workflow.HomeTeam = gameInfo.HomeTeam.DisplayName
-		workflow.HomeScore = gameInfo.CurrentScore[gameInfo.HomeTeam.ID]
+		workflow.HomeScore = gameInfo.ScoreFor(gameInfo.HomeTeam)
 		workflow.AwayTeam = gameInfo.AwayTeam.DisplayName
-		workflow.AwayScore = gameInfo.CurrentScore[gameInfo.AwayTeam.ID]
+		workflow.AwayScore = gameInfo.ScoreFor(gameInfo.AwayTeam)
+		workflow.HomeTeamLogo = gameInfo.HomeTeam.LogoURL
+		workflow.AwayTeamLogo = gameInfo.AwayTeam.LogoURL
 		workflow.StartTime = gameInfo.StartTime
 		workflow.GameID = gameInfo.ID
+		workflow.GameURL = gameInfo.GameURL
+	}
 
-		gameWorkflows = append(gameWorkflows, workflow)
+	if detailed {
+		h.addExecutionDetails(&workflow)
 	}
+	return workflow
+}
 
-	// Sort workflows by StartTime
-	sort.Slice(gameWorkflows, func(i, j int) bool {
-		return gameWorkflows[i].StartTime.Before(gameWorkflows[j].StartTime)
+// workflowURL links to the workflow in the Temporal UI, based on TEMPORAL_HOST
+func (h *Handlers) workflowURL(workflowID string, runID string) string {
+	var tempURL = fmt.Sprintf("/namespaces/%s/workflows/%s/%s", h.config.TemporalNamespace, workflowID, runID)
+	if h.config.TemporalHost != "localhost:7233" {
+		return fmt.Sprintf("https://cloud.temporal.io%s", tempURL)
+	}
+	return fmt.Sprintf("http://localhost:8233%s", tempURL)
+}
+
+// GetCompletedWorkflows returns GameWorkflows that finished within ?since= (default 24h), most recent first:
+// /api/workflows/completed
+func (h *Handlers) GetCompletedWorkflows(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	since := defaultCompletedSince
+	if value := r.URL.Query().Get("since"); value != "" {
+		parsed, err := time.ParseDuration(value)
+		if err != nil || parsed <= 0 {
+			http.Error(w, fmt.Sprintf("Invalid since duration: %q", value), http.StatusBadRequest)
+			return
+		}
+		since = parsed
+	}
+
+	completed := []CompletedGameWorkflow{}
+
+	// Check if Temporal client is available
+	if h.temporalClient == nil {
+		// Return empty list in demo mode
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(completed)
+		return
+	}
+
+	// A wide ?since= can run to more than one page
+	query := buildCompletedGamesQuery(time.Now().Add(-since))
+	var executions []*workflowpb.WorkflowExecutionInfo
+	var pageToken []byte
+	for {
+		resp, err := h.temporalClient.ListWorkflow(r.Context(), &workflowservice.ListWorkflowExecutionsRequest{
+			Query:         query,
+			NextPageToken: pageToken,
+		})
+		if err != nil {
+			// Log error but don't fail the request - list what we've got
+			fmt.Printf("Failed to list completed workflows: %v\n", err)
+			break
+		}
+		executions = append(executions, resp.Executions...)
+		pageToken = resp.NextPageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+
+	// Fetching each result is an RPC per game, so run a few at a time like GetWorkflows does
+	completed = make([]CompletedGameWorkflow, len(executions))
+	h.forEachConcurrently(len(executions), func(i int) {
+		completed[i] = h.completedGameWorkflow(r.Context(), executions[i])
+	})
+
+	sort.Slice(completed, func(i, j int) bool {
+		return completed[i].CloseTime.After(completed[j].CloseTime)
 	})
 
 	w.Header().Set("Content-Type", "application/json")
-	json.NewEncoder(w).Encode(gameWorkflows)
+	json.NewEncoder(w).Encode(completed)
+}
+
+// completedGameWorkflow builds the listing for one completed GameWorkflow from its result
+func (h *Handlers) completedGameWorkflow(ctx context.Context, execution *workflowpb.WorkflowExecutionInfo) CompletedGameWorkflow {
+	workflow := CompletedGameWorkflow{
+		WorkflowID:  execution.Execution.WorkflowId,
+		RunID:       execution.Execution.RunId,
+		WorkflowURL: h.workflowURL(execution.Execution.WorkflowId, execution.Execution.RunId),
+		GameID:      strings.TrimPrefix(execution.Execution.WorkflowId, "game-"),
+	}
+	if execution.GetCloseTime() != nil {
+		workflow.CloseTime = execution.GetCloseTime().AsTime()
+	}
+
+	// GameWorkflow returns the final score as its result
+	err := h.temporalClient.GetWorkflow(ctx, workflow.WorkflowID, workflow.RunID).Get(ctx, &workflow.Result)
+	if err != nil {
+		// Still list the game, just without the result
+		fmt.Printf("Failed to get result for workflow %s: %v\n", workflow.WorkflowID, err)
+	}
+	return workflow
+}
+
+// buildCompletedGamesQuery builds the visibility query for GameWorkflows that completed at or after closedAfter
+func buildCompletedGamesQuery(closedAfter time.Time) string {
+	return fmt.Sprintf("WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Completed' AND CloseTime >= '%s'", closedAfter.UTC().Format(time.RFC3339))
 }
 
-// ManageWorkflow handles workflow management (cancel, etc.)
+// GetSportScores returns live scores for every tracked game in a sport, grouped by league: /api/sports/{sport}/scores
+func (h *Handlers) GetSportScores(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	pathParts := strings.Split(strings.TrimPrefix(r.URL.Path, "/api/sports/"), "/")
+	if len(pathParts) != 2 || pathParts[0] == "" || pathParts[1] != "scores" {
+		http.NotFound(w, r)
+		return
+	}
+	sport := pathParts[0]
+
+	scores := map[string][]GameScore{}
+
+	// Check if Temporal client is available
+	if h.temporalClient == nil {
+		// Return no leagues in demo mode
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(scores)
+		return
+	}
+
+	query, err := buildRunningGamesQuery(sport, "")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := h.temporalClient.ListWorkflow(context.Background(), &workflowservice.ListWorkflowExecutionsRequest{
+		Query: query,
+	})
+	if err != nil {
+		// Log error but don't fail the request - return no leagues
+		fmt.Printf("Failed to list workflows: %v\n", err)
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(scores)
+		return
+	}
+
+	var games []sports.Game
+	for _, execution := range resp.Executions {
+		gameInfo, err := h.queryGameInfo(execution.Execution.WorkflowId, execution.Execution.RunId)
+		if err != nil {
+			// No score to show without the game info, so leave it off the scoreboard
+			fmt.Printf("Failed to get game info for workflow %s: %v\n", execution.Execution.WorkflowId, err)
+			continue
+		}
+		games = append(games, gameInfo)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(groupGameScores(games))
+}
+
+// groupGameScores trims games down to their scores and groups them by league, earliest game first within each league
+func groupGameScores(games []sports.Game) map[string][]GameScore {
+	scores := map[string][]GameScore{}
+	for _, game := range games {
+		scores[game.League] = append(scores[game.League], GameScore{
+			GameID:    game.ID,
+			HomeTeam:  game.HomeTeam.DisplayName,
+			HomeScore: game.ScoreFor(game.HomeTeam),
+			AwayTeam:  game.AwayTeam.DisplayName,
+			AwayScore: game.ScoreFor(game.AwayTeam),
+			Period:    game.CurrentPeriod,
+			Clock:     game.DisplayClock,
+			StartTime: game.StartTime,
+		})
+	}
+	for _, leagueScores := range scores {
+		sort.Slice(leagueScores, func(i, j int) bool {
+			return leagueScores[i].StartTime.Before(leagueScores[j].StartTime)
+		})
+	}
+	return scores
+}
+
+// queryGameInfo runs GameWorkflow's gameInfo query
+func (h *Handlers) queryGameInfo(workflowID string, runID string) (sports.Game, error) {
+	var gameInfo sports.Game
+	gameInfoResult, err := h.temporalClient.QueryWorkflow(context.Background(), workflowID, runID, "gameInfo")
+	if err != nil {
+		return gameInfo, fmt.Errorf("failed to query workflow: %w", err)
+	}
+	if err := gameInfoResult.Get(&gameInfo); err != nil {
+		return gameInfo, fmt.Errorf("failed to get query result: %w", err)
+	}
+	return gameInfo, nil
+}
+
+// buildRunningGamesQuery builds the visibility query for running GameWorkflows, filtering on the Sport/League search attributes when given
+func buildRunningGamesQuery(sport string, league string) (string, error) {
+	query := "WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Running'"
+	filters := []struct {
+		attribute string
+		value     string
+	}{
+		{attribute: sports.SportSearchAttribute.GetName(), value: sport},
+		{attribute: sports.LeagueSearchAttribute.GetName(), value: league},
+	}
+	for _, filter := range filters {
+		if filter.value == "" {
+			continue
+		}
+		if strings.ContainsAny(filter.value, "'\"\\") {
+			return "", fmt.Errorf("invalid %s filter: %s", strings.ToLower(filter.attribute), filter.value)
+		}
+		query += fmt.Sprintf(" AND %s = '%s'", filter.attribute, filter.value)
+	}
+	return query, nil
+}
+
+// addExecutionDetails fills in execution metadata from DescribeWorkflowExecution
+func (h *Handlers) addExecutionDetails(workflow *GameWorkflow) {
+	desc, err := h.temporalClient.DescribeWorkflowExecution(context.Background(), workflow.WorkflowID, workflow.RunID)
+	if err != nil {
+		fmt.Printf("Failed to describe workflow %s: %v\n", workflow.WorkflowID, err)
+		return
+	}
+
+	info := desc.GetWorkflowExecutionInfo()
+	if info.GetStartTime() != nil {
+		executionStartTime := info.GetStartTime().AsTime()
+		workflow.ExecutionStartTime = &executionStartTime
+	}
+	workflow.HistoryLength = info.GetHistoryLength()
+}
+
+// ManageWorkflow handles workflow management (cancel, poll interval changes, signals)
 func (h *Handlers) ManageWorkflow(w http.ResponseWriter, r *http.Request) {
 	workflowID := strings.TrimPrefix(r.URL.Path, "/api/workflows/")
 	if workflowID == "" {
@@ -386,6 +760,12 @@ func (h *Handlers) ManageWorkflow(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	// /api/workflows/{id}/signal
+	if id, ok := strings.CutSuffix(workflowID, "/signal"); ok {
+		h.signalWorkflow(w, r, id)
+		return
+	}
+
 	switch r.Method {
 	case http.MethodDelete:
 		// Check if Temporal client is available
@@ -411,7 +791,196 @@ func (h *Handlers) ManageWorkflow(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		json.NewEncoder(w).Encode(response)
 		
+	case http.MethodPatch:
+		h.updatePollInterval(w, r, workflowID)
+
 	default:
 		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
 	}
 }
+
+// PollIntervalRequest is the body of PATCH /api/workflows/{id}
+type PollIntervalRequest struct {
+	PollInterval string `json:"pollInterval"` // e.g. "2m"
+}
+
+// updatePollInterval changes how often a running GameWorkflow checks the score
+func (h *Handlers) updatePollInterval(w http.ResponseWriter, r *http.Request, workflowID string) {
+	var req PollIntervalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+	pollInterval, err := time.ParseDuration(req.PollInterval)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("Invalid pollInterval: %q", req.PollInterval), http.StatusBadRequest)
+		return
+	}
+	if pollInterval < sports.MinPollInterval {
+		http.Error(w, fmt.Sprintf("pollInterval must be at least %s", sports.MinPollInterval), http.StatusBadRequest)
+		return
+	}
+
+	// Check if Temporal client is available
+	if h.temporalClient == nil {
+		response := map[string]string{
+			"message": "Demo mode: Poll interval update received (Temporal server not connected)",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	// An Update (rather than a signal) so we only report success once the workflow has taken the new interval
+	handle, err := h.temporalClient.UpdateWorkflow(r.Context(), workflowID, "", sports.SetPollIntervalUpdate, pollInterval)
+	if err == nil {
+		err = handle.Get(r.Context(), &pollInterval)
+	}
+	if err != nil {
+		fmt.Printf("Failed to update poll interval for workflow %s: %v\n", workflowID, err)
+		http.Error(w, fmt.Sprintf("Failed to update poll interval: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]string{
+		"message":      "Poll interval updated successfully",
+		"pollInterval": pollInterval.String(),
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// signalArgs maps each signal the UI is allowed to send to the type its args decode into, so nothing else can be
+// signalled. Signals that take no args map to nil.
+var signalArgs = map[string]func() any{
+	sports.AddGameSignal:         func() any { return &sports.Game{} },
+	sports.StopTrackingSignal:    func() any { return nil },
+	sports.UpdateStartTimeSignal: func() any { return &time.Time{} },
+}
+
+// SignalRequest is the body of POST /api/workflows/{id}/signal
+type SignalRequest struct {
+	Name string          `json:"name"`
+	Args json.RawMessage `json:"args"`
+}
+
+// signalWorkflow passes an allowed signal through to a running workflow
+func (h *Handlers) signalWorkflow(w http.ResponseWriter, r *http.Request, workflowID string) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if workflowID == "" {
+		http.Error(w, "Workflow ID required", http.StatusBadRequest)
+		return
+	}
+
+	var req SignalRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	newArgs, ok := signalArgs[req.Name]
+	if !ok {
+		http.Error(w, fmt.Sprintf("Unknown signal: %q", req.Name), http.StatusBadRequest)
+		return
+	}
+	args := newArgs()
+	if args == nil {
+		if len(req.Args) > 0 && string(req.Args) != "null" {
+			http.Error(w, fmt.Sprintf("Signal %s takes no args", req.Name), http.StatusBadRequest)
+			return
+		}
+	} else if len(req.Args) > 0 {
+		if err := json.Unmarshal(req.Args, args); err != nil {
+			http.Error(w, fmt.Sprintf("Invalid args for signal %s: %v", req.Name, err), http.StatusBadRequest)
+			return
+		}
+	}
+	// A zero start time would have the game start polling straight away
+	if startTime, ok := args.(*time.Time); ok && startTime.IsZero() {
+		http.Error(w, fmt.Sprintf("Signal %s needs a start time, e.g. \"2024-11-30T17:00:00Z\"", req.Name), http.StatusBadRequest)
+		return
+	}
+
+	// Check if Temporal client is available
+	if h.temporalClient == nil {
+		response := map[string]string{
+			"message": "Demo mode: Workflow signal request received (Temporal server not connected)",
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(response)
+		return
+	}
+
+	if err := h.temporalClient.SignalWorkflow(r.Context(), workflowID, "", req.Name, args); err != nil {
+		fmt.Printf("Failed to signal workflow %s with %s: %v\n", workflowID, req.Name, err)
+		http.Error(w, fmt.Sprintf("Failed to signal workflow: %v", err), http.StatusInternalServerError)
+		return
+	}
+
+	response := map[string]string{
+		"message": "Signal sent successfully",
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// TestNotification sends a canned notification to one channel so users can check their config works
+func (h *Handlers) TestNotification(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var req struct {
+		Channel string `json:"channel"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil || req.Channel == "" {
+		http.Error(w, "Request body must include a channel", http.StatusBadRequest)
+		return
+	}
+
+	var err error
+	if h.temporalClient == nil {
+		// Demo mode: no worker to run the activity, so send it from here
+		err = sports.SendNotificationListActivity(r.Context(), sports.SendNotifications{
+			Channel:          req.Channel,
+			NotificationList: []sports.Notification{sports.NewTestNotification()},
+		})
+	} else {
+		err = h.sendTestNotificationWorkflow(r.Context(), req.Channel)
+	}
+
+	response := map[string]any{
+		"channel": req.Channel,
+		"success": err == nil,
+	}
+	if err != nil {
+		response["error"] = err.Error()
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(response)
+}
+
+// sendTestNotificationWorkflow runs SendTestNotificationWorkflow on the worker and waits for it to finish
+func (h *Handlers) sendTestNotificationWorkflow(ctx context.Context, channel string) error {
+	if err := h.Validate(); err != nil {
+		return err
+	}
+
+	options := client.StartWorkflowOptions{
+		ID:        fmt.Sprintf("notify-test-%s-%s", channel, time.Now().Format("20060102-150405")),
+		TaskQueue: h.config.TaskQueue,
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, time.Minute)
+	defer cancel()
+
+	we, err := h.temporalClient.ExecuteWorkflow(ctx, options, sports.SendTestNotificationWorkflow, channel)
+	if err != nil {
+		return fmt.Errorf("failed to start workflow: %w", err)
+	}
+	return we.Get(ctx, nil)
+}
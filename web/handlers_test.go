@@ -3,11 +3,25 @@ package web
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/client"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/mocks"
+	"google.golang.org/protobuf/types/known/timestamppb"
 	sports "temporal-sports-tracker"
 )
 
@@ -123,12 +137,29 @@ func TestGetLeagues(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Len(t, leagues, tt.expectedCount)
 			}
+			if tt.expectedStatus == http.StatusBadRequest {
+				var errResp ErrorResponse
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &errResp))
+				assert.NotEmpty(t, errResp.Error)
+			}
 		})
 	}
 }
 
 func TestGetConferences(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"groups": [{"groupId": "50", "name": "NCAA Division I", "children": [
+			{"groupId": "5", "name": "Big Ten Conference", "shortName": "Big Ten"},
+			{"groupId": "8", "name": "Southeastern Conference", "shortName": "SEC"},
+			{"groupId": "1", "name": "Atlantic Coast Conference", "shortName": "ACC"},
+			{"groupId": "4", "name": "Big 12 Conference", "shortName": "Big 12"},
+			{"groupId": "15", "name": "Mid-American Conference", "shortName": "MAC"}
+		]}]}`))
+	}))
+	defer server.Close()
+
 	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
 
 	tests := []struct {
 		name           string
@@ -158,6 +189,13 @@ func TestGetConferences(t *testing.T) {
 			expectedStatus: http.StatusOK,
 			minCount:       0,
 		},
+		{
+			name:           "unknown league",
+			method:         http.MethodGet,
+			path:           "/api/conferences/football/ping-pong",
+			expectedStatus: http.StatusBadRequest,
+			minCount:       0,
+		},
 		{
 			name:           "missing parameters",
 			method:         http.MethodGet,
@@ -200,6 +238,105 @@ func TestGetConferences(t *testing.T) {
 	}
 }
 
+func TestGetConferences_ESPNDown(t *testing.T) {
+	// Nothing's been loaded from ESPN yet, and it can't be reached - no made-up list, just a 502
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/conferences/basketball/mens-college-basketball", nil)
+	w := httptest.NewRecorder()
+	handlers.GetConferences(w, req)
+	assert.Equal(t, http.StatusBadGateway, w.Code)
+}
+
+func TestGetConferences_UnknownLeague(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Errorf("ESPN shouldn't be asked about %s", r.URL.Path)
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	tests := []struct {
+		name           string
+		path           string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{"league without conferences", "/api/conferences/football/nfl", http.StatusOK, "[]\n"},
+		{"unknown league", "/api/conferences/table-tennis/ping-pong", http.StatusBadRequest, `{"error":"Unsupported league: table-tennis/ping-pong"}` + "\n"},
+		{"league under the wrong sport", "/api/conferences/basketball/nfl", http.StatusBadRequest, `{"error":"Unsupported league: basketball/nfl"}` + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+			handlers.GetConferences(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestGetConferences_FromESPN(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/football/college-football/groups", r.URL.Path)
+		w.Write([]byte(`{"groups": [{"groupId": "80", "name": "NCAA Division I-A", "children": [
+			{"groupId": "5", "name": "Big Ten Conference", "shortName": "Big Ten"},
+			{"groupId": "18", "name": "FBS Independents"}
+		]}]}`))
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/conferences/football/college-football", nil)
+	w := httptest.NewRecorder()
+	handlers.GetConferences(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var conferences []Conference
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &conferences))
+	assert.Equal(t, []Conference{{ID: "5", Name: "Big Ten"}, {ID: "18", Name: "FBS Independents"}}, conferences)
+}
+
+func TestGetConferences_Divisions(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"groups": [{"groupId": "80", "name": "NCAA Division I-A", "children": [
+			{"groupId": "8", "name": "Southeastern Conference", "shortName": "SEC", "children": [
+				{"groupId": "12", "name": "SEC - East", "shortName": "SEC East"},
+				{"groupId": "13", "name": "SEC - West"}
+			]}
+		]}]}`))
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/conferences/football/college-football", nil)
+	w := httptest.NewRecorder()
+	handlers.GetConferences(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var conferences []Conference
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &conferences))
+	assert.Equal(t, []Conference{{ID: "8", Name: "SEC", Divisions: []Conference{
+		{ID: "12", Name: "SEC East", Parent: "8"},
+		{ID: "13", Name: "SEC - West", Parent: "8"},
+	}}}, conferences)
+}
+
 func TestStartTracking_DemoMode(t *testing.T) {
 	handlers := NewHandlers(nil) // Demo mode (no Temporal client)
 
@@ -354,6 +491,47 @@ func TestManageWorkflow_DemoMode(t *testing.T) {
 	}
 }
 
+func TestGetAllLeagues(t *testing.T) {
+	handlers := NewHandlers(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/leagues", nil)
+	w := httptest.NewRecorder()
+	handlers.GetAllLeagues(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	var sportLeagues []SportLeagues
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &sportLeagues))
+
+	leaguesBySport := make(map[string][]string)
+	for _, sport := range sportLeagues {
+		for _, league := range sport.Leagues {
+			leaguesBySport[sport.ID] = append(leaguesBySport[sport.ID], league.ID)
+		}
+	}
+	assert.Equal(t, []string{"nfl", "college-football"}, leaguesBySport["football"])
+	assert.Equal(t, []string{"nba", "mens-college-basketball", "womens-college-basketball"}, leaguesBySport["basketball"])
+	assert.Len(t, sportLeagues, 5)
+
+	// Same sports, in the same order, as /api/sports
+	sportsReq := httptest.NewRequest(http.MethodGet, "/api/sports", nil)
+	sportsW := httptest.NewRecorder()
+	handlers.GetSports(sportsW, sportsReq)
+	var sports []Sport
+	require.NoError(t, json.Unmarshal(sportsW.Body.Bytes(), &sports))
+	for i, sport := range sports {
+		assert.Equal(t, sport, sportLeagues[i].Sport)
+	}
+}
+
+func TestGetAllLeagues_MethodNotAllowed(t *testing.T) {
+	handlers := NewHandlers(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/leagues", nil)
+	w := httptest.NewRecorder()
+	handlers.GetAllLeagues(w, req)
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
 func TestGetTeams(t *testing.T) {
 	handlers := NewHandlers(nil)
 
@@ -411,9 +589,754 @@ func TestGetTeams(t *testing.T) {
 	}
 }
 
+// newMockClientWithGames returns a mocked Temporal client listing one running GameWorkflow per game,
+// answering each workflow's gameInfo query with that game
+func newMockClientWithGames(t *testing.T, games ...sports.Game) *mocks.Client {
+	temporalClient := mocks.NewClient(t)
+
+	var executions []*workflowpb.WorkflowExecutionInfo
+	for _, game := range games {
+		workflowID := "game-" + game.ID
+		runID := "run-" + game.ID
+		executions = append(executions, &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		})
+
+		payloads, err := converter.GetDefaultDataConverter().ToPayloads(game)
+		require.NoError(t, err)
+		temporalClient.On("QueryWorkflow", mock.Anything, workflowID, runID, "gameInfo").Return(client.NewValue(payloads), nil)
+	}
+
+	temporalClient.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{Executions: executions}, nil)
+	return temporalClient
+}
+
+func TestGetWorkflows_Detailed(t *testing.T) {
+	game := sports.Game{
+		ID:           "401520281",
+		StartTime:    time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC),
+		HomeTeam:     sports.Team{ID: "130", DisplayName: "Michigan Wolverines", LogoURL: "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png"},
+		AwayTeam:     sports.Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+		CurrentScore: map[string]string{"130": "13", "194": "10"},
+	}
+	executionStartTime := time.Date(2024, 11, 30, 16, 59, 0, 0, time.UTC)
+
+	tests := []struct {
+		name            string
+		path            string
+		expectDescribe  bool
+		expectedDetails bool
+	}{
+		{
+			name:            "default response has no execution details",
+			path:            "/api/workflows",
+			expectDescribe:  false,
+			expectedDetails: false,
+		},
+		{
+			name:            "detailed response includes execution details",
+			path:            "/api/workflows?detailed=true",
+			expectDescribe:  true,
+			expectedDetails: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			temporalClient := newMockClientWithGames(t, game)
+			if tt.expectDescribe {
+				temporalClient.On("DescribeWorkflowExecution", mock.Anything, "game-401520281", "run-401520281").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+					WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+						StartTime:     timestamppb.New(executionStartTime),
+						HistoryLength: 42,
+					},
+				}, nil).Once()
+			}
+			handlers := NewHandlers(temporalClient)
+
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			w := httptest.NewRecorder()
+			handlers.GetWorkflows(w, req)
+			assert.Equal(t, http.StatusOK, w.Code)
+
+			var workflows []map[string]any
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &workflows))
+			require.Len(t, workflows, 1)
+			assert.Equal(t, "Michigan Wolverines", workflows[0]["homeTeam"])
+			assert.Equal(t, "https://a.espncdn.com/i/teamlogos/ncaa/500/130.png", workflows[0]["homeTeamLogo"])
+			assert.NotContains(t, workflows[0], "awayTeamLogo")
+
+			if tt.expectedDetails {
+				assert.Equal(t, executionStartTime.Format(time.RFC3339), workflows[0]["executionStartTime"])
+				assert.Equal(t, float64(42), workflows[0]["historyLength"])
+			} else {
+				assert.NotContains(t, workflows[0], "executionStartTime")
+				assert.NotContains(t, workflows[0], "historyLength")
+			}
+		})
+	}
+}
+
+func TestGetWorkflows_ScoreKeyedByAbbreviation(t *testing.T) {
+	// The scores came back keyed by abbreviation instead of team ID
+	game := sports.Game{
+		ID:           "401520281",
+		HomeTeam:     sports.Team{ID: "130", Abbreviation: "MICH", DisplayName: "Michigan Wolverines"},
+		AwayTeam:     sports.Team{ID: "194", Abbreviation: "OSU", DisplayName: "Ohio State Buckeyes"},
+		CurrentScore: map[string]string{"MICH": "13", "OSU": "10"},
+	}
+	handlers := NewHandlers(newMockClientWithGames(t, game))
+
+	req := httptest.NewRequest(http.MethodGet, "/api/workflows", nil)
+	w := httptest.NewRecorder()
+	handlers.GetWorkflows(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var workflows []map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &workflows))
+	require.Len(t, workflows, 1)
+	assert.Equal(t, "13", workflows[0]["homeScore"])
+	assert.Equal(t, "10", workflows[0]["awayScore"])
+}
+
+func TestGetWorkflows_QueryFailure(t *testing.T) {
+	temporalClient := mocks.NewClient(t)
+	temporalClient.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{
+		Executions: []*workflowpb.WorkflowExecutionInfo{
+			{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: "game-401520281", RunId: "run-401520281"},
+				Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			},
+		},
+	}, nil)
+	temporalClient.On("QueryWorkflow", mock.Anything, "game-401520281", "run-401520281", "gameInfo").Return(nil, assert.AnError)
+
+	handlers := NewHandlers(temporalClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/workflows", nil)
+	w := httptest.NewRecorder()
+	assert.NotPanics(t, func() {
+		handlers.GetWorkflows(w, req)
+	})
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var workflows []GameWorkflow
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &workflows))
+	require.Len(t, workflows, 1)
+	assert.Equal(t, "game-401520281", workflows[0].WorkflowID)
+	assert.Equal(t, "Unavailable", workflows[0].Status)
+	assert.Equal(t, "401520281", workflows[0].GameID)
+	assert.Empty(t, workflows[0].HomeTeam)
+	assert.Empty(t, workflows[0].AwayTeam)
+}
+
+func TestGetWorkflows_ConcurrentQueries(t *testing.T) {
+	kickoff := time.Date(2024, 11, 30, 12, 0, 0, 0, time.UTC)
+	temporalClient := mocks.NewClient(t)
+
+	// Six games listed in no particular order. The latest game answers its query first and the earliest last,
+	// so the queries finish in the opposite order they should be listed in.
+	startOffsets := []int{3, 0, 5, 1, 4, 2}
+	var executions []*workflowpb.WorkflowExecutionInfo
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	for _, offset := range startOffsets {
+		game := sports.Game{
+			ID:        fmt.Sprintf("40152028%d", offset),
+			StartTime: kickoff.Add(time.Duration(offset) * time.Hour),
+			HomeTeam:  sports.Team{ID: "130", DisplayName: "Michigan Wolverines"},
+			AwayTeam:  sports.Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+		}
+		workflowID := "game-" + game.ID
+		runID := "run-" + game.ID
+		executions = append(executions, &workflowpb.WorkflowExecutionInfo{
+			Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+			Status:    enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		})
+
+		payloads, err := converter.GetDefaultDataConverter().ToPayloads(game)
+		require.NoError(t, err)
+		delay := time.Duration(len(startOffsets)-offset) * 10 * time.Millisecond
+		temporalClient.On("QueryWorkflow", mock.Anything, workflowID, runID, "gameInfo").Run(func(args mock.Arguments) {
+			mu.Lock()
+			inFlight++
+			maxInFlight = max(maxInFlight, inFlight)
+			mu.Unlock()
+			time.Sleep(delay)
+			mu.Lock()
+			inFlight--
+			mu.Unlock()
+		}).Return(client.NewValue(payloads), nil)
+	}
+	temporalClient.On("ListWorkflow", mock.Anything, mock.Anything).Return(&workflowservice.ListWorkflowExecutionsResponse{Executions: executions}, nil)
+
+	handlers := NewHandlers(temporalClient)
+	handlers.config.GameInfoConcurrency = 3
+
+	req := httptest.NewRequest(http.MethodGet, "/api/workflows", nil)
+	w := httptest.NewRecorder()
+	handlers.GetWorkflows(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var workflows []GameWorkflow
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &workflows))
+	require.Len(t, workflows, len(startOffsets))
+	for i, workflow := range workflows {
+		assert.Equal(t, fmt.Sprintf("40152028%d", i), workflow.GameID)
+		assert.Equal(t, kickoff.Add(time.Duration(i)*time.Hour), workflow.StartTime.UTC())
+		assert.Equal(t, "Michigan Wolverines", workflow.HomeTeam)
+	}
+	assert.Greater(t, maxInFlight, 1, "queries should run in parallel")
+	assert.LessOrEqual(t, maxInFlight, 3, "no more than GameInfoConcurrency queries at once")
+}
+
+func TestGetSportScores_DemoMode(t *testing.T) {
+	handlers := NewHandlers(nil)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/sports/football/scores", nil)
+	w := httptest.NewRecorder()
+	handlers.GetSportScores(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.JSONEq(t, `{}`, w.Body.String())
+}
+
+func TestGetSportScores_BadPath(t *testing.T) {
+	handlers := NewHandlers(nil)
+
+	for _, path := range []string{"/api/sports/football", "/api/sports/football/teams", "/api/sports//scores"} {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		w := httptest.NewRecorder()
+		handlers.GetSportScores(w, req)
+		assert.Equal(t, http.StatusNotFound, w.Code, path)
+	}
+}
+
+func TestGetSportScores_GroupsByLeague(t *testing.T) {
+	kickoff := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+	games := []sports.Game{
+		{
+			ID:            "401520281",
+			League:        "college-football",
+			StartTime:     kickoff.Add(3 * time.Hour),
+			HomeTeam:      sports.Team{ID: "130", DisplayName: "Michigan Wolverines"},
+			AwayTeam:      sports.Team{ID: "194", DisplayName: "Ohio State Buckeyes"},
+			CurrentScore:  map[string]string{"130": "13", "194": "10"},
+			CurrentPeriod: "3",
+			DisplayClock:  "4:12",
+		},
+		{
+			ID:           "401520282",
+			League:       "college-football",
+			StartTime:    kickoff,
+			HomeTeam:     sports.Team{ID: "2", DisplayName: "Auburn Tigers"},
+			AwayTeam:     sports.Team{ID: "333", DisplayName: "Alabama Crimson Tide"},
+			CurrentScore: map[string]string{"2": "24", "333": "27"},
+		},
+		{
+			ID:           "401671789",
+			League:       "nfl",
+			StartTime:    kickoff,
+			HomeTeam:     sports.Team{ID: "8", DisplayName: "Detroit Lions"},
+			AwayTeam:     sports.Team{ID: "3", DisplayName: "Chicago Bears"},
+			CurrentScore: map[string]string{"8": "23", "3": "20"},
+		},
+	}
+	temporalClient := newMockClientWithGames(t, games...)
+	handlers := NewHandlers(temporalClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/sports/football/scores", nil)
+	w := httptest.NewRecorder()
+	handlers.GetSportScores(w, req)
+	require.Equal(t, http.StatusOK, w.Code)
+
+	// Narrowed to the sport server-side
+	listRequest := temporalClient.Calls[0].Arguments.Get(1).(*workflowservice.ListWorkflowExecutionsRequest)
+	assert.Contains(t, listRequest.Query, "Sport = 'football'")
+
+	var scores map[string][]GameScore
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &scores))
+	require.Len(t, scores, 2)
+
+	// Earliest game first within each league
+	require.Len(t, scores["college-football"], 2)
+	assert.Equal(t, "401520282", scores["college-football"][0].GameID)
+	assert.Equal(t, GameScore{
+		GameID:    "401520281",
+		HomeTeam:  "Michigan Wolverines",
+		HomeScore: "13",
+		AwayTeam:  "Ohio State Buckeyes",
+		AwayScore: "10",
+		Period:    "3",
+		Clock:     "4:12",
+		StartTime: kickoff.Add(3 * time.Hour),
+	}, scores["college-football"][1])
+
+	require.Len(t, scores["nfl"], 1)
+	assert.Equal(t, "Detroit Lions", scores["nfl"][0].HomeTeam)
+	assert.Equal(t, "23", scores["nfl"][0].HomeScore)
+}
+
+func TestHandlers_Validate(t *testing.T) {
+	tests := []struct {
+		name          string
+		withClient    bool
+		taskQueue     string
+		expectedError string
+	}{
+		{name: "demo mode needs no task queue", withClient: false, taskQueue: ""},
+		{name: "client with task queue", withClient: true, taskQueue: "sports-tracker-task-queue"},
+		{name: "client without task queue", withClient: true, taskQueue: "", expectedError: "TASK_QUEUE environment variable is not set"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TASK_QUEUE", tt.taskQueue)
+
+			var temporalClient client.Client
+			if tt.withClient {
+				temporalClient = mocks.NewClient(t)
+			}
+			handlers := NewHandlers(temporalClient)
+
+			err := handlers.Validate()
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestStartTracking_MissingTaskQueue(t *testing.T) {
+	t.Setenv("TASK_QUEUE", "")
+
+	// Never gets as far as starting a workflow
+	handlers := NewHandlers(mocks.NewClient(t))
+
+	req := httptest.NewRequest(http.MethodPost, "/api/track", strings.NewReader(`{"sport": "football", "league": "nfl"}`))
+	w := httptest.NewRecorder()
+	handlers.StartTracking(w, req)
+
+	assert.Equal(t, http.StatusInternalServerError, w.Code)
+	assert.Contains(t, w.Body.String(), "TASK_QUEUE")
+}
+
+func TestStartTracking_DemoModeWithoutTaskQueue(t *testing.T) {
+	// Demo mode reads none of the worker config, so neither a missing TASK_QUEUE nor a bad activity setting matters
+	t.Setenv("TASK_QUEUE", "")
+	t.Setenv("ACTIVITY_RETRY_BACKOFF", "not-a-number")
+
+	handlers := NewHandlers(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/track", strings.NewReader(`{"sport": "football", "league": "nfl"}`))
+	w := httptest.NewRecorder()
+	handlers.StartTracking(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var response map[string]string
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Contains(t, response["message"], "Demo mode")
+	assert.True(t, strings.HasPrefix(response["workflowId"], "demo-workflow-"))
+}
+
+func TestBuildRunningGamesQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		sport         string
+		league        string
+		expectedQuery string
+		expectedError bool
+	}{
+		{
+			name:          "no filters",
+			expectedQuery: "WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Running'",
+		},
+		{
+			name:          "sport and league",
+			sport:         "football",
+			league:        "college-football",
+			expectedQuery: "WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Running' AND Sport = 'football' AND League = 'college-football'",
+		},
+		{
+			name:          "quote in filter is rejected",
+			sport:         "football' OR 1=1",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			query, err := buildRunningGamesQuery(tt.sport, tt.league)
+			if tt.expectedError {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expectedQuery, query)
+		})
+	}
+}
+
+func TestGetCompletedWorkflows_DemoMode(t *testing.T) {
+	handlers := NewHandlers(nil) // Demo mode
+
+	tests := []struct {
+		name           string
+		method         string
+		path           string
+		expectedStatus int
+	}{
+		{
+			name:           "demo mode returns empty list",
+			method:         http.MethodGet,
+			path:           "/api/workflows/completed",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "since filter",
+			method:         http.MethodGet,
+			path:           "/api/workflows/completed?since=6h",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "invalid since",
+			method:         http.MethodGet,
+			path:           "/api/workflows/completed?since=yesterday",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "negative since",
+			method:         http.MethodGet,
+			path:           "/api/workflows/completed?since=-1h",
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid method",
+			method:         http.MethodPost,
+			path:           "/api/workflows/completed",
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			w := httptest.NewRecorder()
+
+			handlers.GetCompletedWorkflows(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var workflows []CompletedGameWorkflow
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &workflows))
+				assert.NotNil(t, workflows)
+				assert.Empty(t, workflows)
+			}
+		})
+	}
+}
+
+func TestGetCompletedWorkflows_Pages(t *testing.T) {
+	closedAt := time.Date(2024, 11, 30, 20, 0, 0, 0, time.UTC)
+	temporalClient := mocks.NewClient(t)
+
+	// Five games over two pages of listing, each result fetched on its own
+	var mu sync.Mutex
+	inFlight, maxInFlight := 0, 0
+	pages := [][]*workflowpb.WorkflowExecutionInfo{}
+	for page := range 2 {
+		var executions []*workflowpb.WorkflowExecutionInfo
+		for i := range 3 - page {
+			n := page*3 + i
+			workflowID := fmt.Sprintf("game-40152028%d", n)
+			runID := fmt.Sprintf("run-%d", n)
+			executions = append(executions, &workflowpb.WorkflowExecutionInfo{
+				Execution: &commonpb.WorkflowExecution{WorkflowId: workflowID, RunId: runID},
+				CloseTime: timestamppb.New(closedAt.Add(time.Duration(n) * time.Minute)),
+			})
+
+			run := mocks.NewWorkflowRun(t)
+			run.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				mu.Lock()
+				inFlight++
+				maxInFlight = max(maxInFlight, inFlight)
+				mu.Unlock()
+				time.Sleep(20 * time.Millisecond)
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+				*args.Get(1).(*string) = fmt.Sprintf("Final score: game %d", n)
+			}).Return(nil)
+			temporalClient.On("GetWorkflow", mock.Anything, workflowID, runID).Return(run)
+		}
+		pages = append(pages, executions)
+	}
+	temporalClient.On("ListWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListWorkflowExecutionsRequest) bool {
+		return len(req.NextPageToken) == 0
+	})).Return(&workflowservice.ListWorkflowExecutionsResponse{Executions: pages[0], NextPageToken: []byte("page-2")}, nil).Once()
+	temporalClient.On("ListWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.ListWorkflowExecutionsRequest) bool {
+		return string(req.NextPageToken) == "page-2"
+	})).Return(&workflowservice.ListWorkflowExecutionsResponse{Executions: pages[1]}, nil).Once()
+
+	handlers := NewHandlers(temporalClient)
+	handlers.config.GameInfoConcurrency = 2
+
+	req := httptest.NewRequest(http.MethodGet, "/api/workflows/completed?since=720h", nil)
+	w := httptest.NewRecorder()
+	handlers.GetCompletedWorkflows(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var workflows []CompletedGameWorkflow
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &workflows))
+	require.Len(t, workflows, 5, "games on the second page should be listed too")
+	for i, workflow := range workflows {
+		n := 4 - i // most recent first
+		assert.Equal(t, fmt.Sprintf("40152028%d", n), workflow.GameID)
+		assert.Equal(t, fmt.Sprintf("Final score: game %d", n), workflow.Result)
+	}
+	assert.Greater(t, maxInFlight, 1, "results should be fetched in parallel")
+	assert.LessOrEqual(t, maxInFlight, 2, "no more than GameInfoConcurrency results at once")
+}
+
+func TestBuildCompletedGamesQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		closedAfter   time.Time
+		expectedQuery string
+	}{
+		{
+			name:          "UTC",
+			closedAfter:   time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC),
+			expectedQuery: "WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Completed' AND CloseTime >= '2024-11-30T17:00:00Z'",
+		},
+		{
+			name:          "other time zones are converted to UTC",
+			closedAfter:   time.Date(2024, 11, 30, 12, 0, 0, 0, time.FixedZone("EST", -5*60*60)),
+			expectedQuery: "WorkflowId STARTS_WITH 'game-' AND ExecutionStatus = 'Completed' AND CloseTime >= '2024-11-30T17:00:00Z'",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedQuery, buildCompletedGamesQuery(tt.closedAfter))
+		})
+	}
+}
+
+func TestTestNotification_DemoMode(t *testing.T) {
+	handlers := NewHandlers(nil) // Demo mode
+
+	tests := []struct {
+		name            string
+		method          string
+		body            string
+		expectedStatus  int
+		expectedSuccess bool
+		expectedError   string
+	}{
+		{
+			name:            "logger channel succeeds",
+			method:          http.MethodPost,
+			body:            `{"channel":"logger"}`,
+			expectedStatus:  http.StatusOK,
+			expectedSuccess: true,
+		},
+		{
+			name:            "unknown channel reports the error",
+			method:          http.MethodPost,
+			body:            `{"channel":"carrier-pigeon"}`,
+			expectedStatus:  http.StatusOK,
+			expectedSuccess: false,
+			expectedError:   "unknown notification channel: carrier-pigeon",
+		},
+		{
+			name:           "missing channel",
+			method:         http.MethodPost,
+			body:           `{}`,
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name:           "invalid method",
+			method:         http.MethodGet,
+			expectedStatus: http.StatusMethodNotAllowed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(tt.method, "/api/notify/test", bytes.NewBufferString(tt.body))
+			w := httptest.NewRecorder()
+
+			handlers.TestNotification(w, req)
+
+			assert.Equal(t, tt.expectedStatus, w.Code)
+
+			if tt.expectedStatus == http.StatusOK {
+				var response map[string]any
+				require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+				assert.Equal(t, tt.expectedSuccess, response["success"])
+				if tt.expectedError != "" {
+					assert.Contains(t, response["error"], tt.expectedError)
+				} else {
+					assert.NotContains(t, response, "error")
+				}
+			}
+		})
+	}
+}
+
+// testScoreboard is a trimmed-down ESPN scoreboard with a single game
+const testScoreboard = `{
+	"events": [
+		{
+			"id": "401520281",
+			"competitions": [
+				{
+					"id": "401520281",
+					"competitors": [
+						{"team": {"id": "130", "name": "Wolverines", "abbreviation": "MICH", "displayName": "Michigan Wolverines", "conferenceId": "5"}, "score": "0", "homeAway": "home"},
+						{"team": {"id": "194", "name": "Buckeyes", "abbreviation": "OSU", "displayName": "Ohio State Buckeyes", "conferenceId": "5"}, "score": "0", "homeAway": "away"}
+					]
+				}
+			]
+		}
+	]
+}`
+
+func TestGetTeams_TeamsEndpointFallback(t *testing.T) {
+	// An off day: nothing on the scoreboard, but the teams endpoint has the whole league
+	var requestedPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.Path)
+		switch r.URL.Path {
+		case "/football/nfl/scoreboard":
+			w.Write([]byte(`{"events": []}`))
+		case "/football/nfl/teams":
+			assert.Equal(t, "1000", r.URL.Query().Get("limit"))
+			w.Write([]byte(`{"sports": [{"leagues": [{"teams": [
+				{"team": {"id": "8", "name": "Lions", "displayName": "Detroit Lions", "abbreviation": "DET",
+					"logos": [{"href": "https://a.espncdn.com/i/teamlogos/nfl/500/det.png"}]}},
+				{"team": {"id": "3", "name": "Bears", "displayName": "Chicago Bears", "abbreviation": "CHI"}},
+				{"team": {"id": "9", "name": "Packers", "displayName": "Green Bay Packers", "abbreviation": "GB"}}
+			]}]}]}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/teams/football/nfl", nil)
+	w := httptest.NewRecorder()
+	handlers.GetTeams(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var teams []sports.Team
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &teams))
+	assert.Equal(t, []sports.Team{
+		{ID: "3", Name: "Bears", DisplayName: "Chicago Bears", Abbreviation: "CHI"},
+		{ID: "8", Name: "Lions", DisplayName: "Detroit Lions", Abbreviation: "DET", LogoURL: "https://a.espncdn.com/i/teamlogos/nfl/500/det.png"},
+		{ID: "9", Name: "Packers", DisplayName: "Green Bay Packers", Abbreviation: "GB"},
+	}, teams)
+	assert.Equal(t, []string{"/football/nfl/scoreboard", "/football/nfl/teams"}, requestedPaths)
+}
+
+func TestGetTeams_Cache(t *testing.T) {
+	var requestedPaths []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPaths = append(requestedPaths, r.URL.Path)
+		w.Write([]byte(testScoreboard))
+	}))
+	defer server.Close()
+
+	handlers := NewHandlers(nil)
+	handlers.espn = sports.NewESPNClient(server.URL)
+
+	for i := 0; i < 2; i++ {
+		req := httptest.NewRequest(http.MethodGet, "/api/teams/football/college-football", nil)
+		w := httptest.NewRecorder()
+		handlers.GetTeams(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var teams []sports.Team
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &teams))
+		require.Len(t, teams, 2)
+		assert.Equal(t, "Michigan Wolverines", teams[0].DisplayName)
+		assert.Equal(t, "Ohio State Buckeyes", teams[1].DisplayName)
+	}
+
+	// The second request is served from the cache
+	assert.Equal(t, []string{"/football/college-football/scoreboard"}, requestedPaths)
+
+	// A different league isn't
+	req := httptest.NewRequest(http.MethodGet, "/api/teams/football/nfl", nil)
+	handlers.GetTeams(httptest.NewRecorder(), req)
+	assert.Equal(t, []string{"/football/college-football/scoreboard", "/football/nfl/scoreboard"}, requestedPaths)
+}
+
+func TestGetTeams_ErrorStatusCodes(t *testing.T) {
+	t.Run("ESPN failure is a bad gateway", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		handlers := NewHandlers(nil)
+		handlers.espn = sports.NewESPNClient(server.URL)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/teams/football/college-football", nil)
+		w := httptest.NewRecorder()
+		handlers.GetTeams(w, req)
+
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+	})
+
+	t.Run("ESPN garbage is a bad gateway", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte("<html>Service Unavailable</html>"))
+		}))
+		defer server.Close()
+
+		handlers := NewHandlers(nil)
+		handlers.espn = sports.NewESPNClient(server.URL)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/teams/football/college-football", nil)
+		w := httptest.NewRecorder()
+		handlers.GetTeams(w, req)
+
+		assert.Equal(t, http.StatusBadGateway, w.Code)
+	})
+
+	t.Run("internal error is a 500", func(t *testing.T) {
+		handlers := NewHandlers(nil)
+		handlers.espn = nil // Misconfigured handlers panic when they try to use the client
+
+		req := httptest.NewRequest(http.MethodGet, "/api/teams/football/college-football", nil)
+		w := httptest.NewRecorder()
+		handlers.GetTeams(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+}
+
 // Integration test for handlers
 func TestHandlersIntegration(t *testing.T) {
+	// Conferences come from ESPN, so stand in for it
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"groups": [{"groupId": "80", "name": "NCAA Division I-A", "children": [
+			{"groupId": "5", "name": "Big Ten Conference", "shortName": "Big Ten"}
+		]}]}`))
+	}))
+	defer server.Close()
+
 	handlers := NewHandlers(nil) // Demo mode
+	handlers.espn = sports.NewESPNClient(server.URL)
 
 	// Test the full flow: sports -> leagues -> conferences -> start tracking
 	
@@ -493,3 +1416,190 @@ func BenchmarkGetLeagues(b *testing.B) {
 		handlers.GetLeagues(w, req)
 	}
 }
+
+func TestSignalWorkflow(t *testing.T) {
+	t.Run("allowed signal", func(t *testing.T) {
+		temporalClient := mocks.NewClient(t)
+		temporalClient.On("SignalWorkflow", mock.Anything, "sports-20241130-120000", "", sports.AddGameSignal, mock.MatchedBy(func(game *sports.Game) bool {
+			return game.ID == "401520281"
+		})).Return(nil)
+		handlers := NewHandlers(temporalClient)
+
+		body := `{"name": "addGame", "args": {"ID": "401520281", "Status": "pre"}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/workflows/sports-20241130-120000/signal", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Signal sent successfully")
+	})
+
+	t.Run("stopTracking takes no args", func(t *testing.T) {
+		temporalClient := mocks.NewClient(t)
+		temporalClient.On("SignalWorkflow", mock.Anything, "game-401520281", "", sports.StopTrackingSignal, nil).Return(nil)
+		handlers := NewHandlers(temporalClient)
+
+		body := `{"name": "stopTracking"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/workflows/game-401520281/signal", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Signal sent successfully")
+	})
+
+	t.Run("stopTracking with args", func(t *testing.T) {
+		handlers := NewHandlers(mocks.NewClient(t))
+
+		body := `{"name": "stopTracking", "args": {"reason": "blowout"}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/workflows/game-401520281/signal", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "takes no args")
+	})
+
+	t.Run("updateStartTime", func(t *testing.T) {
+		kickoff := time.Date(2024, 11, 30, 17, 0, 0, 0, time.UTC)
+		temporalClient := mocks.NewClient(t)
+		temporalClient.On("SignalWorkflow", mock.Anything, "game-401520281", "", sports.UpdateStartTimeSignal, mock.MatchedBy(func(startTime *time.Time) bool {
+			return startTime.Equal(kickoff)
+		})).Return(nil)
+		handlers := NewHandlers(temporalClient)
+
+		body := `{"name": "updateStartTime", "args": "2024-11-30T12:00:00-05:00"}`
+		req := httptest.NewRequest(http.MethodPost, "/api/workflows/game-401520281/signal", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Signal sent successfully")
+	})
+
+	t.Run("updateStartTime without a time", func(t *testing.T) {
+		handlers := NewHandlers(mocks.NewClient(t))
+
+		for _, body := range []string{`{"name": "updateStartTime"}`, `{"name": "updateStartTime", "args": "tomorrow"}`} {
+			req := httptest.NewRequest(http.MethodPost, "/api/workflows/game-401520281/signal", strings.NewReader(body))
+			w := httptest.NewRecorder()
+			handlers.ManageWorkflow(w, req)
+			assert.Equal(t, http.StatusBadRequest, w.Code, body)
+		}
+	})
+
+	t.Run("disallowed signal", func(t *testing.T) {
+		// No SignalWorkflow expectation - the mock fails the test if it's called
+		handlers := NewHandlers(mocks.NewClient(t))
+
+		body := `{"name": "somethingElse", "args": {}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/workflows/sports-20241130-120000/signal", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "Unknown signal")
+	})
+
+	t.Run("demo mode", func(t *testing.T) {
+		handlers := NewHandlers(nil)
+
+		body := `{"name": "addGame", "args": {"ID": "401520281"}}`
+		req := httptest.NewRequest(http.MethodPost, "/api/workflows/sports-20241130-120000/signal", strings.NewReader(body))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Demo mode")
+	})
+
+	t.Run("wrong method", func(t *testing.T) {
+		handlers := NewHandlers(nil)
+
+		req := httptest.NewRequest(http.MethodGet, "/api/workflows/sports-20241130-120000/signal", nil)
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+	})
+}
+
+func TestUpdatePollInterval(t *testing.T) {
+	t.Run("valid update", func(t *testing.T) {
+		handle := mocks.NewWorkflowUpdateHandle(t)
+		handle.On("Get", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			*args.Get(1).(*time.Duration) = 2 * time.Minute
+		}).Return(nil)
+		temporalClient := mocks.NewClient(t)
+		temporalClient.On("UpdateWorkflow", mock.Anything, "game-401520281", "", sports.SetPollIntervalUpdate, 2*time.Minute).Return(handle, nil)
+		handlers := NewHandlers(temporalClient)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/workflows/game-401520281", strings.NewReader(`{"pollInterval": "2m"}`))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		var response map[string]string
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "2m0s", response["pollInterval"])
+	})
+
+	t.Run("update rejected", func(t *testing.T) {
+		handle := mocks.NewWorkflowUpdateHandle(t)
+		handle.On("Get", mock.Anything, mock.Anything).Return(assert.AnError)
+		temporalClient := mocks.NewClient(t)
+		temporalClient.On("UpdateWorkflow", mock.Anything, "game-401520281", "", sports.SetPollIntervalUpdate, time.Minute).Return(handle, nil)
+		handlers := NewHandlers(temporalClient)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/workflows/game-401520281", strings.NewReader(`{"pollInterval": "1m"}`))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	t.Run("update fails", func(t *testing.T) {
+		temporalClient := mocks.NewClient(t)
+		temporalClient.On("UpdateWorkflow", mock.Anything, "game-401520281", "", sports.SetPollIntervalUpdate, time.Minute).Return(nil, assert.AnError)
+		handlers := NewHandlers(temporalClient)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/workflows/game-401520281", strings.NewReader(`{"pollInterval": "1m"}`))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusInternalServerError, w.Code)
+	})
+
+	invalid := []struct {
+		name string
+		body string
+	}{
+		{"not a duration", `{"pollInterval": "soon"}`},
+		{"below the minimum", `{"pollInterval": "10s"}`},
+		{"missing", `{}`},
+		{"invalid JSON", `pollInterval=2m`},
+	}
+	for _, tt := range invalid {
+		t.Run(tt.name, func(t *testing.T) {
+			// No UpdateWorkflow expectation - the mock fails the test if it's called
+			handlers := NewHandlers(mocks.NewClient(t))
+
+			req := httptest.NewRequest(http.MethodPatch, "/api/workflows/game-401520281", strings.NewReader(tt.body))
+			w := httptest.NewRecorder()
+			handlers.ManageWorkflow(w, req)
+
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+		})
+	}
+
+	t.Run("demo mode", func(t *testing.T) {
+		handlers := NewHandlers(nil)
+
+		req := httptest.NewRequest(http.MethodPatch, "/api/workflows/game-401520281", strings.NewReader(`{"pollInterval": "2m"}`))
+		w := httptest.NewRecorder()
+		handlers.ManageWorkflow(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Contains(t, w.Body.String(), "Demo mode")
+	})
+}
@@ -0,0 +1,54 @@
+package web
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Everything is served under /api/v1/. The unversioned /api/ paths are kept as an alias for existing clients.
+const (
+	apiPrefix   = "/api/"
+	apiV1Prefix = "/api/v1/"
+)
+
+// Routes returns the API's handler. The handlers themselves only know the /api/ paths, so /api/v1/ requests are
+// rewritten to those before they're routed.
+func (h *Handlers) Routes() http.Handler {
+	api := http.NewServeMux()
+	api.HandleFunc("/api/sports", h.GetSports)
+	api.HandleFunc("/api/sports/", h.GetSportScores)
+	api.HandleFunc("/api/leagues", h.GetAllLeagues)
+	api.HandleFunc("/api/leagues/", h.GetLeagues)
+	api.HandleFunc("/api/teams/", h.GetTeams)
+	api.HandleFunc("/api/conferences/", h.GetConferences)
+	api.HandleFunc("/api/track", h.StartTracking)
+	api.HandleFunc("/api/workflows", h.GetWorkflows)
+	api.HandleFunc("/api/workflows/completed", h.GetCompletedWorkflows)
+	api.HandleFunc("/api/workflows/", h.ManageWorkflow)
+	api.HandleFunc("/api/notify/test", h.TestNotification)
+	api.HandleFunc("/api/stats", h.GetStats)
+	api.HandleFunc("/api/game/", h.GetGameDetail)
+	api.HandleFunc("/api/config", h.GetConfig)
+	api.HandleFunc("/api/watchlists", h.Watchlists)
+
+	mux := http.NewServeMux()
+	mux.Handle(apiPrefix, api)
+	mux.Handle(apiV1Prefix, unversioned(api))
+	return mux
+}
+
+// unversioned serves an /api/v1/ request as the matching /api/ one
+func unversioned(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.URL = new(url.URL)
+		*r2.URL = *r.URL
+		r2.URL.Path = apiPrefix + strings.TrimPrefix(r.URL.Path, apiV1Prefix)
+		if r.URL.RawPath != "" {
+			r2.URL.RawPath = apiPrefix + strings.TrimPrefix(r.URL.RawPath, apiV1Prefix)
+		}
+		next.ServeHTTP(w, r2)
+	})
+}
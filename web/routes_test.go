@@ -0,0 +1,48 @@
+package web
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutes_V1Alias(t *testing.T) {
+	routes := NewHandlers(nil).Routes()
+
+	tests := []struct {
+		name   string
+		method string
+		path   string // under /api/ and /api/v1/
+	}{
+		{name: "sports", method: http.MethodGet, path: "sports"},
+		{name: "all leagues", method: http.MethodGet, path: "leagues"},
+		{name: "leagues for a sport", method: http.MethodGet, path: "leagues/football"},
+		{name: "workflows with a query", method: http.MethodGet, path: "workflows?sport=football"},
+		{name: "wrong method", method: http.MethodGet, path: "track"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			legacy := httptest.NewRecorder()
+			routes.ServeHTTP(legacy, httptest.NewRequest(tt.method, "/api/"+tt.path, nil))
+			v1 := httptest.NewRecorder()
+			routes.ServeHTTP(v1, httptest.NewRequest(tt.method, "/api/v1/"+tt.path, nil))
+
+			require.NotEqual(t, http.StatusNotFound, v1.Code)
+			assert.Equal(t, legacy.Code, v1.Code)
+			assert.Equal(t, legacy.Header().Get("Content-Type"), v1.Header().Get("Content-Type"))
+			assert.Equal(t, legacy.Body.String(), v1.Body.String())
+		})
+	}
+}
+
+func TestRoutes_UnknownPath(t *testing.T) {
+	routes := NewHandlers(nil).Routes()
+
+	w := httptest.NewRecorder()
+	routes.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/api/v1/nope", nil))
+	assert.Equal(t, http.StatusNotFound, w.Code)
+}
@@ -0,0 +1,79 @@
+package web
+
+import "slices"
+
+// SportLeagues is a sport along with its leagues
+type SportLeagues struct {
+	Sport
+	Leagues []League `json:"leagues"`
+}
+
+// sportsRegistry is every sport and league we support, in the order the UI shows them
+var sportsRegistry = []SportLeagues{
+	{
+		Sport: Sport{ID: "baseball", Name: "Baseball", Path: "baseball"},
+		Leagues: []League{
+			{ID: "mlb", Name: "MLB", Path: "mlb"},
+		},
+	},
+	{
+		Sport: Sport{ID: "basketball", Name: "Basketball", Path: "basketball"},
+		Leagues: []League{
+			{ID: "nba", Name: "NBA", Path: "nba"},
+			{ID: "mens-college-basketball", Name: "Men's College Basketball", Path: "mens-college-basketball"},
+			{ID: "womens-college-basketball", Name: "Women's College Basketball", Path: "womens-college-basketball"},
+		},
+	},
+	{
+		Sport: Sport{ID: "football", Name: "Football", Path: "football"},
+		Leagues: []League{
+			{ID: "nfl", Name: "NFL", Path: "nfl"},
+			{ID: "college-football", Name: "College Football", Path: "college-football"},
+		},
+	},
+	{
+		Sport: Sport{ID: "hockey", Name: "Hockey", Path: "hockey"},
+		Leagues: []League{
+			{ID: "nhl", Name: "NHL", Path: "nhl"},
+		},
+	},
+	{
+		Sport: Sport{ID: "soccer", Name: "Soccer", Path: "soccer"},
+		Leagues: []League{
+			{ID: "usa.1", Name: "MLS", Path: "usa.1"},
+			{ID: "eng.1", Name: "English Premier League", Path: "eng.1"},
+			{ID: "uefa.champions", Name: "UEFA Champions League", Path: "uefa.champions"},
+		},
+	},
+}
+
+// findSport looks up a sport in the registry by its path, e.g. "football"
+func findSport(path string) (SportLeagues, bool) {
+	for _, sport := range sportsRegistry {
+		if sport.Path == path {
+			return sport, true
+		}
+	}
+	return SportLeagues{}, false
+}
+
+// knownLeague reports whether a league is in the registry under the given sport, e.g. "college-football" under "football"
+func knownLeague(sportPath string, leaguePath string) bool {
+	sport, ok := findSport(sportPath)
+	if !ok {
+		return false
+	}
+	return slices.ContainsFunc(sport.Leagues, func(league League) bool { return league.Path == leaguePath })
+}
+
+// findSportForLeague returns the path of the sport a league belongs to, e.g. "football" for "college-football"
+func findSportForLeague(leaguePath string) (string, bool) {
+	for _, sport := range sportsRegistry {
+		for _, league := range sport.Leagues {
+			if league.Path == leaguePath {
+				return sport.Path, true
+			}
+		}
+	}
+	return "", false
+}
@@ -2,6 +2,7 @@
 let currentSport = '';
 let currentLeague = '';
 let refreshInterval = null;
+let leaguesBySport = {}; // filled in by loadSports from /api/leagues
 
 // DOM elements
 const sportSelect = document.getElementById('sport-select');
@@ -102,7 +103,12 @@ async function apiCall(url, options = {}) {
 // Load sports
 async function loadSports() {
     try {
-        const sports = await apiCall('/api/sports');
+        // One request gets every sport along with its leagues
+        const sports = await apiCall('/api/leagues');
+        leaguesBySport = {};
+        sports.forEach(sport => {
+            leaguesBySport[sport.id] = sport.leagues;
+        });
         populateSelect(sportSelect, sports, 'id', 'name', 'Select a sport...');
     } catch (error) {
         showStatus('Failed to load sports', 'error');
@@ -113,7 +119,7 @@ async function loadSports() {
 async function loadLeagues(sport) {
     try {
         resetLeagueAndBelow();
-        const leagues = await apiCall(`/api/leagues/${sport}`);
+        const leagues = leaguesBySport[sport] || await apiCall(`/api/leagues/${sport}`);
         populateSelect(leagueSelect, leagues, 'id', 'name', 'Select a league...');
     } catch (error) {
         showStatus('Failed to load leagues', 'error');
@@ -137,7 +143,12 @@ async function loadTeamsAndConferences() {
             conferencesSelect.disabled = true;
             
             const conferences = await apiCall(`/api/conferences/${currentSport}/${currentLeague}`);
-            populateSelect(conferencesSelect, conferences, 'id', 'name', '', true);
+            // Divisions (SEC East, SEC West) are listed under their conference and can be picked on their own
+            const options = conferences.flatMap(conf => [
+                conf,
+                ...(conf.divisions || []).map(division => ({ ...division, name: `\u00a0\u00a0${division.name}` }))
+            ]);
+            populateSelect(conferencesSelect, options, 'id', 'name', '', true);
             conferencesSelect.disabled = false;
         } else {
             conferencesSelect.innerHTML = '<option value="">Not available for this league</option>';
@@ -208,9 +219,10 @@ function viewWorkflow(workflowUrl) {
     window.open(temporalUrl, '_blank');
 }
 
-function viewGame(apiRoot, gameId) {
-    const gameUrl = ` https://www.espn.com/college-football/game/_/gameId/${gameId}`;
-    window.open(gameUrl, '_blank');
+function viewGame(gameUrl, gameId) {
+    // Older workflows don't have a gameUrl, so fall back to the college football page
+    const url = gameUrl || `https://www.espn.com/college-football/game/_/gameId/${gameId}`;
+    window.open(url, '_blank');
 }
 
 // Helper functions
@@ -299,7 +311,7 @@ function displayWorkflows(workflows) {
                 <button class="temporal-btn" onclick="viewWorkflow('${workflow.workflowUrl}')" alt="View Workflow in Temporal UI">
                 &nbsp;&nbsp;&nbsp;
                 </button>
-                <button onclick="viewGame('${workflow.gameId}', '${workflow.gameId}')" alt="View Game Info on ESPN">
+                <button onclick="viewGame('${workflow.gameUrl || ''}', '${workflow.gameId}')" alt="View Game Info on ESPN">
                 View Game Info at ESPN.com
                 </button>
                                 
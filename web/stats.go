@@ -0,0 +1,58 @@
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.temporal.io/api/workflowservice/v1"
+)
+
+// Stats is what /api/stats returns, for a simple status widget
+type Stats struct {
+	ActiveGames       int64 `json:"activeGames"`       // GameWorkflows running now
+	GamesTrackedToday int64 `json:"gamesTrackedToday"` // GameWorkflows started since midnight UTC
+}
+
+// GetStats returns the tracker's operational counters: /api/stats
+func (h *Handlers) GetStats(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var stats Stats
+
+	// Check if Temporal client is available - demo mode has nothing to count, so it's all zeros
+	if h.temporalClient != nil {
+		activeQuery, _ := buildRunningGamesQuery("", "") // no filters, so no error
+		stats.ActiveGames = h.countWorkflows(r.Context(), activeQuery)
+		stats.GamesTrackedToday = h.countWorkflows(r.Context(), buildGamesStartedQuery(startOfDay(time.Now())))
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(stats)
+}
+
+// countWorkflows counts the workflows matching query, or returns 0 if Temporal can't say
+func (h *Handlers) countWorkflows(ctx context.Context, query string) int64 {
+	resp, err := h.temporalClient.CountWorkflow(ctx, &workflowservice.CountWorkflowExecutionsRequest{Query: query})
+	if err != nil {
+		// Log error but don't fail the request - the widget just shows 0
+		fmt.Printf("Failed to count workflows for %q: %v\n", query, err)
+		return 0
+	}
+	return resp.GetCount()
+}
+
+// buildGamesStartedQuery builds the visibility query for GameWorkflows started at or after startedAfter, in any state
+func buildGamesStartedQuery(startedAfter time.Time) string {
+	return fmt.Sprintf("WorkflowId STARTS_WITH 'game-' AND StartTime >= '%s'", startedAfter.UTC().Format(time.RFC3339))
+}
+
+// startOfDay returns midnight UTC on t's day
+func startOfDay(t time.Time) time.Time {
+	return t.UTC().Truncate(24 * time.Hour)
+}
@@ -0,0 +1,70 @@
+package web
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/mocks"
+)
+
+func TestGetStats_DemoMode(t *testing.T) {
+	handlers := NewHandlers(nil) // Demo mode (no Temporal client)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/stats", nil)
+	w := httptest.NewRecorder()
+	handlers.GetStats(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "application/json", w.Header().Get("Content-Type"))
+
+	var stats map[string]any
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+	assert.Equal(t, map[string]any{
+		"activeGames":       float64(0),
+		"gamesTrackedToday": float64(0),
+	}, stats)
+}
+
+func TestGetStats_MethodNotAllowed(t *testing.T) {
+	handlers := NewHandlers(nil)
+
+	req := httptest.NewRequest(http.MethodPost, "/api/stats", nil)
+	w := httptest.NewRecorder()
+	handlers.GetStats(w, req)
+
+	assert.Equal(t, http.StatusMethodNotAllowed, w.Code)
+}
+
+func TestGetStats_CountsFromTemporal(t *testing.T) {
+	temporalClient := mocks.NewClient(t)
+	temporalClient.On("CountWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.CountWorkflowExecutionsRequest) bool {
+		return strings.Contains(req.Query, "ExecutionStatus = 'Running'")
+	})).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 3}, nil).Once()
+	temporalClient.On("CountWorkflow", mock.Anything, mock.MatchedBy(func(req *workflowservice.CountWorkflowExecutionsRequest) bool {
+		return strings.Contains(req.Query, "StartTime >= ")
+	})).Return(&workflowservice.CountWorkflowExecutionsResponse{Count: 7}, nil).Once()
+	handlers := NewHandlers(temporalClient)
+
+	req := httptest.NewRequest(http.MethodGet, "/api/stats", nil)
+	w := httptest.NewRecorder()
+	handlers.GetStats(w, req)
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	var stats Stats
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &stats))
+	assert.Equal(t, int64(3), stats.ActiveGames)
+	assert.Equal(t, int64(7), stats.GamesTrackedToday)
+}
+
+func TestBuildGamesStartedQuery(t *testing.T) {
+	now := time.Date(2024, 11, 30, 19, 45, 0, 0, time.FixedZone("EST", -5*60*60))
+	assert.Equal(t, "WorkflowId STARTS_WITH 'game-' AND StartTime >= '2024-12-01T00:00:00Z'", buildGamesStartedQuery(startOfDay(now)))
+}
@@ -0,0 +1,52 @@
+package web
+
+import (
+	"sync"
+	"time"
+
+	sports "temporal-sports-tracker"
+)
+
+// teamsCacheTTL is how long GetTeams results are reused before going back to ESPN
+const teamsCacheTTL = 10 * time.Minute
+
+// teamsCache keeps the teams for each sport/league so picking a league in the UI doesn't hit ESPN every time
+type teamsCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]teamsCacheEntry
+}
+
+type teamsCacheEntry struct {
+	teams     []sports.Team
+	fetchedAt time.Time
+}
+
+func newTeamsCache(ttl time.Duration) *teamsCache {
+	return &teamsCache{
+		ttl:     ttl,
+		entries: make(map[string]teamsCacheEntry),
+	}
+}
+
+// get returns the cached teams for a sport/league, if there are any that haven't expired
+func (c *teamsCache) get(sport string, league string) ([]sports.Team, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[sport+"/"+league]
+	if !ok || time.Since(entry.fetchedAt) > c.ttl {
+		return nil, false
+	}
+	return entry.teams, true
+}
+
+func (c *teamsCache) set(sport string, league string, teams []sports.Team) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[sport+"/"+league] = teamsCacheEntry{
+		teams:     teams,
+		fetchedAt: time.Now(),
+	}
+}
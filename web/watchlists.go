@@ -0,0 +1,113 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	sports "temporal-sports-tracker"
+)
+
+// Watchlist is a saved set of teams that tracking requests can refer to by name, e.g. the teams on a fantasy roster
+type Watchlist struct {
+	Name   string   `json:"name"`
+	Sport  string   `json:"sport"`
+	League string   `json:"league"`
+	Teams  []string `json:"teams"` // ESPN team IDs, or anything else a tracking request's teams can be
+}
+
+// watchlistStore keeps watchlists in memory, so they last as long as the web server does
+type watchlistStore struct {
+	mu         sync.Mutex
+	watchlists map[string]Watchlist
+}
+
+func newWatchlistStore() *watchlistStore {
+	return &watchlistStore{watchlists: make(map[string]Watchlist)}
+}
+
+// get looks up a watchlist by name, ignoring case
+func (s *watchlistStore) get(name string) (Watchlist, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	watchlist, ok := s.watchlists[strings.ToLower(name)]
+	return watchlist, ok
+}
+
+// put saves a watchlist, replacing any with the same name
+func (s *watchlistStore) put(watchlist Watchlist) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.watchlists[strings.ToLower(watchlist.Name)] = watchlist
+}
+
+// list returns every watchlist, by name
+func (s *watchlistStore) list() []Watchlist {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	watchlists := []Watchlist{}
+	for _, watchlist := range s.watchlists {
+		watchlists = append(watchlists, watchlist)
+	}
+	sort.Slice(watchlists, func(i, j int) bool { return watchlists[i].Name < watchlists[j].Name })
+	return watchlists
+}
+
+// Watchlists saves a watchlist (POST) or lists them (GET): /api/watchlists
+func (h *Handlers) Watchlists(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(h.watchlists.list())
+	case http.MethodPost:
+		var watchlist Watchlist
+		if err := json.NewDecoder(r.Body).Decode(&watchlist); err != nil {
+			http.Error(w, "Invalid request body", http.StatusBadRequest)
+			return
+		}
+		watchlist.Name = strings.TrimSpace(watchlist.Name)
+		if watchlist.Name == "" || len(watchlist.Teams) == 0 {
+			http.Error(w, "A watchlist needs a name and at least one team", http.StatusBadRequest)
+			return
+		}
+		if !knownLeague(watchlist.Sport, watchlist.League) {
+			http.Error(w, fmt.Sprintf("Unsupported league: %s/%s", watchlist.Sport, watchlist.League), http.StatusBadRequest)
+			return
+		}
+
+		h.watchlists.put(watchlist)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		json.NewEncoder(w).Encode(watchlist)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// applyWatchlist adds the teams from req's watchlist to the ones it asked for directly. Team IDs only mean something
+// within a league, so the request takes the watchlist's sport and league, and can't ask for a different one.
+func (h *Handlers) applyWatchlist(req *sports.TrackingRequest) error {
+	if req.Watchlist == "" {
+		return nil
+	}
+	watchlist, ok := h.watchlists.get(req.Watchlist)
+	if !ok {
+		return fmt.Errorf("unknown watchlist: %s", req.Watchlist)
+	}
+	if (req.Sport != "" && req.Sport != watchlist.Sport) || (req.League != "" && req.League != watchlist.League) {
+		return fmt.Errorf("watchlist %s is for %s/%s, not %s/%s", watchlist.Name, watchlist.Sport, watchlist.League, req.Sport, req.League)
+	}
+
+	req.Sport = watchlist.Sport
+	req.League = watchlist.League
+	for _, team := range watchlist.Teams {
+		if !slices.Contains(req.Teams, team) {
+			req.Teams = append(req.Teams, team)
+		}
+	}
+	return nil
+}
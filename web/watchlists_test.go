@@ -0,0 +1,95 @@
+package web
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/sdk/mocks"
+
+	sports "temporal-sports-tracker"
+)
+
+func postJSON(t *testing.T, handler http.HandlerFunc, path string, body any) *httptest.ResponseRecorder {
+	t.Helper()
+	payload, err := json.Marshal(body)
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(payload))
+	w := httptest.NewRecorder()
+	handler(w, req)
+	return w
+}
+
+func TestWatchlists(t *testing.T) {
+	handlers := NewHandlers(nil)
+
+	tests := []struct {
+		name           string
+		watchlist      Watchlist
+		expectedStatus int
+	}{
+		{"saved", Watchlist{Name: "Fantasy QBs", Sport: "football", League: "nfl", Teams: []string{"12", "33"}}, http.StatusCreated},
+		{"no name", Watchlist{Sport: "football", League: "nfl", Teams: []string{"12"}}, http.StatusBadRequest},
+		{"no teams", Watchlist{Name: "Empty", Sport: "football", League: "nfl"}, http.StatusBadRequest},
+		{"unknown league", Watchlist{Name: "Paddles", Sport: "table-tennis", League: "ping-pong", Teams: []string{"1"}}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := postJSON(t, handlers.Watchlists, "/api/watchlists", tt.watchlist)
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+
+	// Only the valid one was kept
+	req := httptest.NewRequest(http.MethodGet, "/api/watchlists", nil)
+	w := httptest.NewRecorder()
+	handlers.Watchlists(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var watchlists []Watchlist
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &watchlists))
+	assert.Equal(t, []Watchlist{{Name: "Fantasy QBs", Sport: "football", League: "nfl", Teams: []string{"12", "33"}}}, watchlists)
+}
+
+func TestStartTracking_Watchlist(t *testing.T) {
+	t.Setenv("TASK_QUEUE", "sports-tracker-task-queue")
+
+	// The collection is started with the watchlist's teams alongside the one asked for directly
+	temporalClient := mocks.NewClient(t)
+	run := mocks.NewWorkflowRun(t)
+	run.On("GetID").Return("sports-watchlist")
+	run.On("GetRunID").Return("run-1")
+	temporalClient.On("ExecuteWorkflow", mock.Anything, mock.Anything, mock.Anything, mock.MatchedBy(func(req sports.TrackingRequest) bool {
+		return req.Sport == "football" && req.League == "nfl" && assert.ObjectsAreEqual([]string{"25", "12", "33"}, req.Teams)
+	})).Return(run, nil).Once()
+
+	handlers := NewHandlers(temporalClient)
+	w := postJSON(t, handlers.Watchlists, "/api/watchlists", Watchlist{Name: "Fantasy QBs", Sport: "football", League: "nfl", Teams: []string{"12", "33", "25"}})
+	require.Equal(t, http.StatusCreated, w.Code)
+
+	t.Run("expanded to its teams", func(t *testing.T) {
+		w := postJSON(t, handlers.StartTracking, "/api/track", sports.TrackingRequest{Teams: []string{"25"}, Watchlist: "fantasy qbs"})
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var response map[string]string
+		require.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+		assert.Equal(t, "sports-watchlist", response["workflowId"])
+	})
+
+	t.Run("unknown watchlist", func(t *testing.T) {
+		w := postJSON(t, handlers.StartTracking, "/api/track", sports.TrackingRequest{Watchlist: "Dynasty"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "unknown watchlist: Dynasty")
+	})
+
+	t.Run("different league", func(t *testing.T) {
+		w := postJSON(t, handlers.StartTracking, "/api/track", sports.TrackingRequest{Sport: "football", League: "college-football", Watchlist: "Fantasy QBs"})
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Contains(t, w.Body.String(), "is for football/nfl")
+	})
+}
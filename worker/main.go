@@ -2,37 +2,49 @@ package main
 
 import (
 	"log"
-	"os"
 	sports "temporal-sports-tracker"
+	_ "time/tzdata" // the alpine image has no zoneinfo, and game reminders can be given in any timezone
 
 	"go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/worker"
 )
 
 func main() {
+	// Load and check the config once, then share it with the activities and workflows
+	cfg, err := sports.LoadConfig()
+	if err != nil {
+		log.Fatalln("Invalid configuration:", err)
+	}
+	if cfg.TaskQueue == "" {
+		log.Fatalln("TASK_QUEUE environment variable is not set")
+	}
+	sports.SetConfig(cfg)
+
 	// Create Temporal client
-	c, err := client.Dial(sports.GetClientOptions())
+	c, err := client.Dial(sports.NewClientOptions(cfg))
 	if err != nil {
 		log.Fatalln("Unable to create Temporal client", err)
 	}
 	defer c.Close()
 
-	TaskQueueName := os.Getenv("TASK_QUEUE")
-	if TaskQueueName == "" {
-		log.Fatalln("TASK_QUEUE environment variable is not set")
-	}
 	// Create worker
-	w := worker.New(c, TaskQueueName, worker.Options{})
+	w := worker.New(c, cfg.TaskQueue, worker.Options{})
 
 	// Register workflows
 	w.RegisterWorkflow(sports.CollectGamesWorkflow)
 	w.RegisterWorkflow(sports.GameWorkflow)
+	w.RegisterWorkflow(sports.DigestWorkflow)
+	w.RegisterWorkflow(sports.SendTestNotificationWorkflow)
 
 	// Register activities
 	w.RegisterActivity(sports.GetGamesActivity)
+	w.RegisterActivity(sports.ResolveConferencesActivity)
 	w.RegisterActivity(sports.StartGameWorkflowActivity)
 	w.RegisterActivity(sports.GetGameScoreActivity)
+	w.RegisterActivity(sports.GetWinProbabilityActivity)
+	w.RegisterActivity(sports.GetScoringPlaysActivity)
 	w.RegisterActivity(sports.SendNotificationListActivity)
+	w.RegisterActivity(sports.RecordGameResultActivity)
 
 	// Start worker
 	log.Println("Starting Temporal worker for sports tracker...")